@@ -0,0 +1,113 @@
+package tiny
+
+// AutomatonBoundary decides what lies beyond either edge of an elementary cellular automaton.
+type AutomatonBoundary int
+
+const (
+	// WrapBoundary joins the edges, so the first and last cells neighbor one another.
+	WrapBoundary AutomatonBoundary = iota
+
+	// ZeroBoundary treats every cell beyond the edges as a 0.
+	ZeroBoundary
+
+	// OneBoundary treats every cell beyond the edges as a 1.
+	OneBoundary
+)
+
+// stepAutomaton evolves the cells by a single generation of the rule, writing the generation into next.
+func stepAutomaton(rule uint8, cells []Bit, next []Bit, boundary AutomatonBoundary) {
+	n := len(cells)
+	edge := Zero
+	if boundary == OneBoundary {
+		edge = One
+	}
+
+	for i := range cells {
+		left, right := edge, edge
+		if i > 0 {
+			left = cells[i-1]
+		} else if boundary == WrapBoundary {
+			left = cells[n-1]
+		}
+		if i < n-1 {
+			right = cells[i+1]
+		} else if boundary == WrapBoundary {
+			right = cells[0]
+		}
+		neighborhood := left<<2 | cells[i]<<1 | right
+		next[i] = Bit(rule>>neighborhood) & 1
+	}
+}
+
+// Automaton evolves the seed through the provided number of generations of an elementary cellular automaton,
+// returning the final generation.
+//
+// Each cell's next state is the bit of the rule indexed by its neighborhood - the cell to its left, itself,
+// and the cell to its right, read as a 3-bit number.
+//
+// @formatter:off
+//
+// For example, Rule 30:
+//
+//	Neighborhood | 1 1 1 | 1 1 0 | 1 0 1 | 1 0 0 | 0 1 1 | 0 1 0 | 0 0 1 | 0 0 0
+//	  Next State |   0   |   0   |   0   |   1   |   1   |   1   |   1   |   0     ← 30 = 0 0 0 1 1 1 1 0
+//
+// @formatter:on
+//
+// Only the current and next generations are held while evolving - see AutomatonHistory to keep every generation.
+//
+// NOTE: This will panic if the generation count is negative.
+func (_ Synthesizer) Automaton(rule uint8, seed Phrase, generations int, boundary AutomatonBoundary) Phrase {
+	if generations < 0 {
+		panic("cannot evolve an automaton through a negative number of generations")
+	}
+
+	cells := seed.Bits()
+	next := make([]Bit, len(cells))
+	for i := 0; i < generations; i++ {
+		stepAutomaton(rule, cells, next, boundary)
+		cells, next = next, cells
+	}
+	return NewPhraseFromBits(cells...)
+}
+
+// AutomatonHistory evolves the seed just as Automaton does, but returns every generation - starting with
+// the seed itself.
+//
+// NOTE: This will panic if the generation count is negative.
+func (_ Synthesizer) AutomatonHistory(rule uint8, seed Phrase, generations int, boundary AutomatonBoundary) []Phrase {
+	if generations < 0 {
+		panic("cannot evolve an automaton through a negative number of generations")
+	}
+
+	cells := seed.Bits()
+	history := make([]Phrase, 0, generations+1)
+	history = append(history, NewPhraseFromBits(cells...))
+	next := make([]Bit, len(cells))
+	for i := 0; i < generations; i++ {
+		stepAutomaton(rule, cells, next, boundary)
+		cells, next = next, cells
+		history = append(history, NewPhraseFromBits(cells...))
+	}
+	return history
+}
+
+// AutomatonGenerator creates a bit generator which emits the center cell of every successive generation of
+// the automaton - Rule 30's center column being the classic example.
+//
+// The generator fits RandomBits and RandomPhraseCustom, which call it for every bit they need.
+//
+// NOTE: This will panic if the seed is empty.
+func (_ Synthesizer) AutomatonGenerator(rule uint8, seed Phrase, boundary AutomatonBoundary) func(int) Bit {
+	cells := seed.Bits()
+	if len(cells) == 0 {
+		panic("cannot generate bits from an empty automaton")
+	}
+	next := make([]Bit, len(cells))
+	return func(_ int) Bit {
+		bit := cells[len(cells)/2]
+		stepAutomaton(rule, cells, next, boundary)
+		cells, next = next, cells
+		return bit
+	}
+}
@@ -0,0 +1,176 @@
+package tiny
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Composition holds the starting conditions to coordinate recursively performing movements.
+//
+// At every level of recursion, each movement is performed against the current data and the shortest
+// result is kept as the next level's input.  The walk terminates when any of the following holds:
+//
+//   - The data has reached the TargetWidth
+//   - The pathway has reached the MaxDepth
+//   - No movement yields any further gain
+//
+// If a GrowthScheme is provided, the Movement of each level's Growth is performed rather than branching
+// across every movement.
+//
+// The steps taken are recorded as a Pathway, which can later be replayed backwards to recover the seed.
+type Composition struct {
+	// Seed is the starting information to be recursively transformed.
+	Seed Phrase
+
+	// Movements is the set of movements available at every level of recursion.
+	Movements []Movement
+
+	// TargetWidth stops the walk once the data is this many bits wide or narrower.
+	//
+	// NOTE: A value of 0 or less disables this condition.
+	TargetWidth int
+
+	// MaxDepth stops the walk after the provided number of steps.
+	//
+	// NOTE: A value of 0 or less disables this condition.
+	MaxDepth int
+
+	// Concurrent performs every movement of a level in its own goroutine.
+	Concurrent bool
+
+	// Scheme optionally decides which movement to perform at each level of recursion.
+	Scheme GrowthScheme
+}
+
+// NewComposition creates a Composition of the provided seed and movements.
+func NewComposition(seed Phrase, movements ...Movement) Composition {
+	return Composition{
+		Seed:      seed,
+		Movements: movements,
+	}
+}
+
+// branch holds the outcome of performing a single movement at one level of recursion.
+type branch struct {
+	movement int
+	result   Phrase
+	err      error
+}
+
+// Perform recursively walks the composition's movements against the seed, returning the final result
+// and the pathway taken to reach it.
+//
+// NOTE: If the provided context is cancelled, the walk stops and returns the context's error along
+// with the result and pathway reached up to that point.  The context is handed to every movement, so
+// a movement which checks it can be interrupted partway through a level.
+func (c Composition) Perform(ctx context.Context) (result Phrase, pathway Pathway, err error) {
+	if len(c.Movements) == 0 {
+		panic("cannot perform a composition without any movements")
+	}
+
+	result = c.Seed
+	pathway = make(Pathway, 0)
+
+	for {
+		width := result.BitLength()
+		if c.TargetWidth > 0 && width <= c.TargetWidth {
+			return result, pathway, nil
+		}
+		if c.MaxDepth > 0 && len(pathway) >= c.MaxDepth {
+			return result, pathway, nil
+		}
+		if err = ctx.Err(); err != nil {
+			return result, pathway, err
+		}
+
+		var branches []branch
+		if c.Scheme != nil {
+			branches = c.performScheme(ctx, len(pathway), result)
+		} else {
+			branches = c.performLevel(ctx, result)
+		}
+
+		best := -1
+		for i, b := range branches {
+			if b.err != nil {
+				return result, pathway, b.err
+			}
+			if best < 0 || b.result.BitLength() < branches[best].result.BitLength() {
+				best = i
+			}
+		}
+
+		// No further gain
+		if branches[best].result.BitLength() >= width {
+			return result, pathway, nil
+		}
+
+		result = branches[best].result
+		pathway = append(pathway, Step{
+			Movement:    branches[best].movement,
+			InputWidth:  width,
+			OutputWidth: result.BitLength(),
+		})
+	}
+}
+
+// performLevel performs every movement against the provided data, fanning out across goroutines
+// if the composition is concurrent.
+func (c Composition) performLevel(ctx context.Context, data Phrase) []branch {
+	branches := make([]branch, len(c.Movements))
+
+	perform := func(i int) {
+		if err := ctx.Err(); err != nil {
+			branches[i] = branch{movement: i, err: err}
+			return
+		}
+		out, err := c.Movements[i].Forward(ctx, data)
+		branches[i] = branch{movement: i, result: out, err: err}
+	}
+
+	if !c.Concurrent {
+		for i := range c.Movements {
+			perform(i)
+		}
+		return branches
+	}
+
+	var wg sync.WaitGroup
+	for i := range c.Movements {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			perform(i)
+		}(i)
+	}
+	wg.Wait()
+	return branches
+}
+
+// performScheme performs only the movement the composition's scheme grows for the provided level.
+func (c Composition) performScheme(ctx context.Context, level int, data Phrase) []branch {
+	m := c.Scheme.Grow(level, data.BitLength()).Movement
+	if m < 0 || m >= len(c.Movements) {
+		return []branch{{movement: m, err: fmt.Errorf("growth scheme chose unknown movement %d", m)}}
+	}
+	out, err := c.Movements[m].Forward(ctx, data)
+	return []branch{{movement: m, result: out, err: err}}
+}
+
+// Replay walks the provided pathway backwards from the result, reversing every movement to recover the seed.
+func (c Composition) Replay(result Phrase, pathway Pathway) (seed Phrase, err error) {
+	seed = result
+	for i := len(pathway) - 1; i >= 0; i-- {
+		step := pathway[i]
+		if step.Movement < 0 || step.Movement >= len(c.Movements) {
+			return nil, fmt.Errorf("pathway step %d references unknown movement %d", i, step.Movement)
+		}
+
+		seed, err = c.Movements[step.Movement].Reverse(seed, step.InputWidth)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return seed, nil
+}
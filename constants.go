@@ -111,6 +111,74 @@ const ErrorMsgEndOfBits = "no more bits to read"
 
 var ErrorEndOfBits = fmt.Errorf(ErrorMsgEndOfBits)
 
+const ErrorMsgDNAFormat = "malformed DNA file"
+
+var ErrorDNAFormat = fmt.Errorf(ErrorMsgDNAFormat)
+
+const ErrorMsgDNAVersion = "unsupported DNA file version"
+
+var ErrorDNAVersion = fmt.Errorf(ErrorMsgDNAVersion)
+
+const ErrorMsgDNAChecksum = "DNA file checksum mismatch"
+
+var ErrorDNAChecksum = fmt.Errorf(ErrorMsgDNAChecksum)
+
+const ErrorMsgMedleyFormat = "malformed medley"
+
+var ErrorMedleyFormat = fmt.Errorf(ErrorMsgMedleyFormat)
+
+const ErrorMsgFuzzyScheme = "invalid fuzzy scheme"
+
+var ErrorFuzzyScheme = fmt.Errorf(ErrorMsgFuzzyScheme)
+
+const ErrorMsgFuzzyKey = "unrecognized fuzzy key"
+
+var ErrorFuzzyKey = fmt.Errorf(ErrorMsgFuzzyKey)
+
+const ErrorMsgFuzzyOverflow = "fuzzy value overflows an int"
+
+var ErrorFuzzyOverflow = fmt.Errorf(ErrorMsgFuzzyOverflow)
+
+const ErrorMsgFuzzyRegistry = "invalid fuzzy registration"
+
+var ErrorFuzzyRegistry = fmt.Errorf(ErrorMsgFuzzyRegistry)
+
+const ErrorMsgFuzzyStream = "malformed fuzzy stream"
+
+var ErrorFuzzyStream = fmt.Errorf(ErrorMsgFuzzyStream)
+
+const ErrorMsgLEB128Overlong = "overlong LEB128 encoding"
+
+var ErrorLEB128Overlong = fmt.Errorf(ErrorMsgLEB128Overlong)
+
+const ErrorMsgHuffmanTable = "invalid Huffman table"
+
+var ErrorHuffmanTable = fmt.Errorf(ErrorMsgHuffmanTable)
+
+const ErrorMsgHuffmanSymbol = "symbol has no Huffman code"
+
+var ErrorHuffmanSymbol = fmt.Errorf(ErrorMsgHuffmanSymbol)
+
+const ErrorMsgHuffmanCode = "unassigned Huffman code"
+
+var ErrorHuffmanCode = fmt.Errorf(ErrorMsgHuffmanCode)
+
+const ErrorMsgRangeCode = "invalid range code"
+
+var ErrorRangeCode = fmt.Errorf(ErrorMsgRangeCode)
+
+const ErrorMsgLFSR = "invalid LFSR"
+
+var ErrorLFSR = fmt.Errorf(ErrorMsgLFSR)
+
+const ErrorMsgTemplate = "invalid template"
+
+var ErrorTemplate = fmt.Errorf(ErrorMsgTemplate)
+
+const ErrorMsgRandomness = "invalid randomness test"
+
+var ErrorRandomness = fmt.Errorf(ErrorMsgRandomness)
+
 /**
 Passages
 */
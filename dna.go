@@ -0,0 +1,234 @@
+package tiny
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// DNAVersion is the current version of the DNA file format.
+const DNAVersion = 1
+
+// dnaMagic identifies the start of every DNA file.
+var dnaMagic = [4]byte{'t', 'D', 'N', 'A'}
+
+// DNA is a durable artifact of a recursive encoding, split into three regions:
+//
+//   - MovementStart - The initial conditions the recursion was started with
+//   - MovementPathway - The sequence of movements walked during the recursion
+//   - MovementSeed - The final delta left at the end of the pathway
+//
+// @formatter:off
+//
+// The DNA file is laid out as follows, with every multi-byte field written big-endian:
+//
+//	| "tDNA" | Version |  Start Region  | Pathway Region |  Seed Region  | CRC-32 |
+//	|   4    |    1    |      ...       |      ...       |      ...      |   4    |
+//
+//	Every region is written as -
+//
+//	| Name Length | Name | Payload Length | Payload |
+//	|      1      |  ... |       4        |   ...   |
+//
+//	The start and seed payloads are phrases -
+//
+//	| Bit Length | Bits (padded to the byte with 0s) |
+//	|     4      |                ...                |
+//
+//	The pathway payload is a count of steps followed by each step -
+//
+//	| Step Count | Movement | Input Width | Output Width | ...
+//	|     4      |    4     |      4      |      4       | ...
+//
+// The checksum is a CRC-32 (IEEE) of every byte preceding it.
+//
+// @formatter:on
+type DNA struct {
+	// Start holds the initial conditions of the recursion.
+	Start Phrase
+
+	// Pathway holds the sequence of movements walked during the recursion.
+	Pathway Pathway
+
+	// Seed holds the final delta left at the end of the pathway.
+	Seed Phrase
+}
+
+// WriteDNA writes the provided DNA to the writer.
+func WriteDNA(w io.Writer, dna DNA) error {
+	buf := new(bytes.Buffer)
+	buf.Write(dnaMagic[:])
+	buf.WriteByte(DNAVersion)
+
+	writeDNARegion(buf, MovementStart, packPhrase(dna.Start))
+
+	pathway := binary.BigEndian.AppendUint32(nil, uint32(len(dna.Pathway)))
+	for _, step := range dna.Pathway {
+		pathway = binary.BigEndian.AppendUint32(pathway, uint32(step.Movement))
+		pathway = binary.BigEndian.AppendUint32(pathway, uint32(step.InputWidth))
+		pathway = binary.BigEndian.AppendUint32(pathway, uint32(step.OutputWidth))
+	}
+	writeDNARegion(buf, MovementPathway, pathway)
+
+	writeDNARegion(buf, MovementSeed, packPhrase(dna.Seed))
+
+	checksum := crc32.ChecksumIEEE(buf.Bytes())
+	buf.Write(binary.BigEndian.AppendUint32(nil, checksum))
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// ReadDNA reads a DNA file from the reader.
+//
+// NOTE: This returns ErrorDNAFormat if the data isn't a DNA file, ErrorDNAVersion if the file was written
+// by an unsupported version, and ErrorDNAChecksum if the file's contents don't match its checksum.
+func ReadDNA(r io.Reader) (DNA, error) {
+	var dna DNA
+	hash := crc32.NewIEEE()
+	tee := io.TeeReader(r, hash)
+
+	var header [5]byte
+	if _, err := io.ReadFull(tee, header[:]); err != nil {
+		return dna, fmt.Errorf("%w: %w", ErrorDNAFormat, err)
+	}
+	if [4]byte(header[:4]) != dnaMagic {
+		return dna, ErrorDNAFormat
+	}
+	if header[4] != DNAVersion {
+		return dna, fmt.Errorf("%w: %d", ErrorDNAVersion, header[4])
+	}
+
+	start, err := readDNARegion(tee, MovementStart)
+	if err != nil {
+		return dna, err
+	}
+	pathway, err := readDNARegion(tee, MovementPathway)
+	if err != nil {
+		return dna, err
+	}
+	seed, err := readDNARegion(tee, MovementSeed)
+	if err != nil {
+		return dna, err
+	}
+
+	expected := hash.Sum32()
+	var checksum [4]byte
+	if _, err = io.ReadFull(r, checksum[:]); err != nil {
+		return dna, fmt.Errorf("%w: %w", ErrorDNAFormat, err)
+	}
+	if binary.BigEndian.Uint32(checksum[:]) != expected {
+		return dna, ErrorDNAChecksum
+	}
+
+	if dna.Start, err = unpackPhrase(start); err != nil {
+		return dna, err
+	}
+	if dna.Pathway, err = unpackPathway(pathway); err != nil {
+		return dna, err
+	}
+	if dna.Seed, err = unpackPhrase(seed); err != nil {
+		return dna, err
+	}
+	return dna, nil
+}
+
+// writeDNARegion writes a named region and its payload to the buffer.
+func writeDNARegion(buf *bytes.Buffer, name string, payload []byte) {
+	buf.WriteByte(byte(len(name)))
+	buf.WriteString(name)
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(payload))))
+	buf.Write(payload)
+}
+
+// readDNARegion reads the next region from the reader, ensuring it's the expected region.
+func readDNARegion(r io.Reader, name string) ([]byte, error) {
+	var nameLength [1]byte
+	if _, err := io.ReadFull(r, nameLength[:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorDNAFormat, err)
+	}
+	found := make([]byte, nameLength[0])
+	if _, err := io.ReadFull(r, found); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorDNAFormat, err)
+	}
+	if string(found) != name {
+		return nil, fmt.Errorf("%w: expected the %s region, found %q", ErrorDNAFormat, name, found)
+	}
+
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorDNAFormat, err)
+	}
+	// The payload grows with the bytes actually present, so a corrupt length can't force a huge allocation
+	expected := int64(binary.BigEndian.Uint32(length[:]))
+	var payload bytes.Buffer
+	if n, err := io.CopyN(&payload, r, expected); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("%w: %s region holds %d of %d bytes: %w", ErrorDNAFormat, name, n, expected, err)
+	}
+	return payload.Bytes(), nil
+}
+
+// unpackPathway parses a pathway region's payload.
+func unpackPathway(payload []byte) (Pathway, error) {
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("%w: truncated %s region", ErrorDNAFormat, MovementPathway)
+	}
+	count := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+	if len(payload) != count*12 {
+		return nil, fmt.Errorf("%w: %s region holds %d bytes for %d steps", ErrorDNAFormat, MovementPathway, len(payload), count)
+	}
+
+	pathway := make(Pathway, count)
+	for i := range pathway {
+		pathway[i] = Step{
+			Movement:    int(binary.BigEndian.Uint32(payload[i*12:])),
+			InputWidth:  int(binary.BigEndian.Uint32(payload[i*12+4:])),
+			OutputWidth: int(binary.BigEndian.Uint32(payload[i*12+8:])),
+		}
+	}
+	return pathway, nil
+}
+
+// packPhrase writes the phrase's exact bit length followed by its bits, padded to the byte with zeros.
+func packPhrase(p Phrase) []byte {
+	bits := p.Bits()
+	out := binary.BigEndian.AppendUint32(nil, uint32(len(bits)))
+	return append(out, packBits(bits)...)
+}
+
+// unpackPhrase parses a phrase written by packPhrase.
+func unpackPhrase(payload []byte) (Phrase, error) {
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("%w: truncated phrase", ErrorDNAFormat)
+	}
+	length := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+	if len(payload) != (length+7)/8 {
+		return nil, fmt.Errorf("%w: phrase of %d bits held in %d bytes", ErrorDNAFormat, length, len(payload))
+	}
+	return NewPhraseFromBits(unpackBits(payload, length)...), nil
+}
+
+// packBits packs the provided bits into bytes, padding the final byte with zeros.
+func packBits(bits []Bit) []byte {
+	out := make([]byte, (len(bits)+7)/8)
+	for i, b := range bits {
+		out[i/8] |= byte(b) << (7 - i%8)
+	}
+	return out
+}
+
+// unpackBits unpacks the provided number of bits from the bytes.
+func unpackBits(data []byte, length int) []Bit {
+	out := make([]Bit, length)
+	for i := range out {
+		out[i] = Bit(data[i/8]>>(7-i%8)) & 1
+	}
+	return out
+}
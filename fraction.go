@@ -0,0 +1,129 @@
+package tiny
+
+import "math/big"
+
+// Fraction synthesizes the points nearest to a rational fraction of the provided width's range - where the
+// range spans from the light boundary, 0, to the dark boundary, 2ʷⁱᵈᵗʰ.
+//
+// Unlike Boundary, the denominator needn't be a power of two - thirds and fifths are just as welcome.
+//
+// @formatter:off
+//
+// For example, a third of a byte's range is 85.33...
+//
+//	  floor | 0 1 0 1 0 1 0 1 | 85
+//	   ceil | 0 1 0 1 0 1 1 0 | 86
+//	nearest | 0 1 0 1 0 1 0 1 | 85
+//
+// @formatter:on
+//
+// NOTE: A fraction of the entire range lands on the dark boundary, which is clamped to all ones.  The
+// nearest point rounds halves up.
+//
+// NOTE: This will panic if the width is negative, or if the fraction isn't within [0, 1].
+func (_ Synthesizer) Fraction(numerator int, denominator int, width int) (floor Phrase, ceil Phrase, nearest Phrase) {
+	if width < 0 {
+		panic("cannot synthesize a fraction of a negative width")
+	}
+	if denominator <= 0 || numerator < 0 || numerator > denominator {
+		panic("cannot synthesize a fraction outside of [0, 1]")
+	}
+
+	den := big.NewInt(int64(denominator))
+	scaled := new(big.Int).Lsh(big.NewInt(int64(numerator)), uint(width))
+	f, remainder := new(big.Int).QuoRem(scaled, den, new(big.Int))
+
+	c := new(big.Int).Set(f)
+	if remainder.Sign() > 0 {
+		c.Add(c, big.NewInt(1))
+	}
+	n := f
+	if new(big.Int).Lsh(remainder, 1).Cmp(den) >= 0 {
+		n = c
+	}
+	return fractionPoint(f, width), fractionPoint(c, width), fractionPoint(n, width)
+}
+
+// fractionPoint emits the value at the provided width, clamping the dark boundary to all ones.
+func fractionPoint(value *big.Int, width int) Phrase {
+	if width == 0 {
+		return Phrase{}
+	}
+	if value.BitLen() > width {
+		return Synthesize.Ones(width)
+	}
+	return NewPhraseFromBits(From.BigInt(value, width)...)
+}
+
+// Location describes where a phrase lies between the boundaries that subdivide its width's range.
+type Location struct {
+	// Interval is the index of the boundary below the phrase.
+	Interval int
+
+	// Lower is the boundary at or below the phrase.
+	Lower Phrase
+
+	// Upper is the boundary above the phrase - or the dark boundary, clamped to all ones, in the final interval.
+	Upper Phrase
+
+	// FromLower is the distance from the lower boundary up to the phrase.
+	FromLower *big.Int
+
+	// ToUpper is the distance from the phrase up to the upper boundary.
+	ToUpper *big.Int
+}
+
+// Locate reports which of the boundary intervals at the provided depth the phrase lies in, and how far
+// it lies from either end.  See Synthesize.AllBoundaries for the boundaries of each depth.
+//
+// NOTE: This will panic if the phrase is empty, or if the depth is negative or wider than the phrase.
+func (a _analyze) Locate(value Phrase, depth int) Location {
+	if depth < 0 || depth > value.BitLength() || depth >= GetArchitectureBitWidth()-1 {
+		panic("cannot locate a phrase at a negative depth, or one wider than the phrase")
+	}
+	return a.LocateFraction(value, 1<<depth)
+}
+
+// LocateFraction reports which interval the phrase lies in when its width's range is split into the
+// provided number of parts, and how far it lies from either end.  Each boundary is the floor of its
+// fraction of the range, as given by Synthesize.Fraction.
+//
+// NOTE: This will panic if the phrase is empty, or if there are fewer than one part.
+func (_ _analyze) LocateFraction(value Phrase, parts int) Location {
+	width := value.BitLength()
+	if width == 0 {
+		panic("cannot locate an empty phrase")
+	}
+	if parts < 1 {
+		panic("cannot split a range into fewer than one part")
+	}
+
+	v := value.AsBigInt()
+	den := big.NewInt(int64(parts))
+
+	// The interval is the largest k where ⌊k·2ʷ/parts⌋ <= v, which is ⌈(v+1)·parts/2ʷ⌉ - 1
+	numerator := new(big.Int).Mul(new(big.Int).Add(v, big.NewInt(1)), den)
+	k, remainder := new(big.Int).QuoRem(numerator, new(big.Int).Lsh(big.NewInt(1), uint(width)), new(big.Int))
+	if remainder.Sign() == 0 {
+		k.Sub(k, big.NewInt(1))
+	}
+	interval := int(k.Int64())
+
+	boundary := func(i int) *big.Int {
+		b := new(big.Int).Lsh(big.NewInt(int64(i)), uint(width))
+		b.Quo(b, den)
+		if b.BitLen() > width {
+			b.Sub(b, big.NewInt(1))
+		}
+		return b
+	}
+	lower, upper := boundary(interval), boundary(interval+1)
+
+	return Location{
+		Interval:  interval,
+		Lower:     NewPhraseFromBits(From.BigInt(lower, width)...),
+		Upper:     NewPhraseFromBits(From.BigInt(upper, width)...),
+		FromLower: new(big.Int).Sub(v, lower),
+		ToUpper:   new(big.Int).Sub(upper, v),
+	}
+}
@@ -140,3 +140,53 @@ func (_ _from) Number(value int, width ...int) []Bit {
 
 	return bits
 }
+
+// ULEB128 encodes the provided value as an unsigned LEB128 - seven bits per byte, least significant group
+// first, with the high bit of every byte except the last set.
+//
+// NOTE: This will panic if given a negative value.
+func (_ _from) ULEB128(value *big.Int) Phrase {
+	if value.Sign() < 0 {
+		panic("cannot encode a negative value as an unsigned LEB128")
+	}
+	v := new(big.Int).Set(value)
+	mask := big.NewInt(0x7F)
+	out := make([]byte, 0)
+	for {
+		b := byte(new(big.Int).And(v, mask).Uint64())
+		v.Rsh(v, 7)
+		if v.Sign() == 0 {
+			return NewPhrase(append(out, b)...)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// SLEB128 encodes the provided value as a signed LEB128 - seven two's complement bits per byte, least
+// significant group first, with the high bit of every byte except the last set.
+func (_ _from) SLEB128(value *big.Int) Phrase {
+	v := new(big.Int).Set(value)
+	mask := big.NewInt(0x7F)
+	out := make([]byte, 0)
+	for {
+		b := byte(new(big.Int).And(v, mask).Uint64())
+		v.Rsh(v, 7)
+		signBit := b&0x40 != 0
+		if (v.Sign() == 0 && !signBit) || (v.Cmp(big.NewInt(-1)) == 0 && signBit) {
+			return NewPhrase(append(out, b)...)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// ZigZag encodes the provided signed value as its unsigned zigzag value, as used by protobuf's sint types.
+// Pair it with ULEB128 to encode a sint.
+//
+//	0 → 0, -1 → 1, 1 → 2, -2 → 3, 2 → 4 ...
+func (_ _from) ZigZag(value *big.Int) *big.Int {
+	out := new(big.Int).Lsh(value, 1)
+	if value.Sign() < 0 {
+		out.Neg(out).Sub(out, big.NewInt(1))
+	}
+	return out
+}
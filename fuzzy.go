@@ -1,5 +1,10 @@
 package tiny
 
+import (
+	"fmt"
+	"math/big"
+)
+
 // _fuzzy is a factory for creating or referencing fuzzy projection functions.
 type _fuzzy struct {
 	// SixtyFour encodes 0-64 in up to a six bit value.
@@ -14,20 +19,43 @@ type _fuzzy struct {
 	// Power ecodes values at a power of 2 interval.
 	Power _power
 
+	// Powers encodes exactly as Power does, but reads back the powers themselves rather than their 2ⁿ - 1 values.
+	Powers _powers
+
 	// ZLE encodes values of any arbitrary bit length.
 	ZLE _zle
 
 	// Byte encodes values from 2-8 bits in length.
 	Byte _byte
+
+	// Unary encodes values as a count of ones.
+	Unary _unary
+
+	// Gamma encodes values of any size using Elias gamma coding.
+	Gamma _gamma
+
+	// Delta encodes values of any size using Elias delta coding.
+	Delta _delta
+
+	// Omega encodes values of any size using Elias omega coding.
+	Omega _omega
+
+	// Fibonacci encodes values of any size using Fibonacci coding.
+	Fibonacci _fibonacci
 }
 
 type _sixtyFour struct{}
 type _five struct{}
 type _fiveCumulative struct{}
 type _power struct{}
-type _zle struct{}
+type _powers struct{ _power }
 type _byte struct{}
 
+type _zle struct {
+	maxKey  int
+	limited bool
+}
+
 // Read uses the below map to parse a value from the next bits in the provided phrase:
 //
 // @formatter:off
@@ -43,7 +71,7 @@ type _byte struct{}
 //
 // @formatter:on
 func (_ _sixtyFour) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne(4)
+	zeros, remainder := readFuzzyKey(data, 4)
 	var projectionRange int
 
 	switch zeros {
@@ -63,6 +91,12 @@ func (_ _sixtyFour) Read(data Phrase) (value int, remainder Phrase) {
 	return To.Number(projectionRange, projection.Bits()...), remainder
 }
 
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (f _sixtyFour) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	value, remainder = f.Read(data)
+	return value, remainder, fuzzyMapShort(data, 4, 8, 16, 32, 64)
+}
+
 // Encode uses the below map to encode a ZLE key and projection from the provided value.
 //
 // @formatter:off
@@ -115,7 +149,7 @@ func (_ _sixtyFour) Encode(value int) (key Phrase, projection Phrase) {
 //
 // @formatter:on
 func (_ _five) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne(4)
+	zeros, remainder := readFuzzyKey(data, 4)
 	var projectionRange int
 
 	switch zeros {
@@ -135,6 +169,12 @@ func (_ _five) Read(data Phrase) (value int, remainder Phrase) {
 	return To.Number(projectionRange, projection.Bits()...), remainder
 }
 
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (f _five) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	value, remainder = f.Read(data)
+	return value, remainder, fuzzyMapShort(data, 1, 2, 3, 4, 5)
+}
+
 // Encode uses the below map to encode a ZLE key and projection from the provided value.
 //
 // @formatter:off
@@ -189,7 +229,7 @@ func (_ _five) Encode(value int) (key Phrase, projection Phrase) {
 //
 // @formatter:on
 func (_ _fiveCumulative) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne(4)
+	zeros, remainder := readFuzzyKey(data, 4)
 	var projectionRange int
 	var shim int
 
@@ -214,6 +254,12 @@ func (_ _fiveCumulative) Read(data Phrase) (value int, remainder Phrase) {
 	return To.Number(projectionRange, projection.Bits()...) + shim, remainder
 }
 
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (f _fiveCumulative) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	value, remainder = f.Read(data)
+	return value, remainder, fuzzyMapShort(data, 1, 2, 3, 4, 5)
+}
+
 // Encode uses the below map to encode a ZLE key and projection from the provided value.
 //
 // @formatter:off
@@ -244,10 +290,11 @@ func (_ _fiveCumulative) Encode(value int) (key Phrase, projection Phrase) {
 		key = NewPhraseFromBits(0, 0, 1)
 	case value < 30:
 		bitLength = 4
-		value -= 30
+		value -= 14
 		key = NewPhraseFromBits(0, 0, 0, 1)
 	case value < 62:
 		bitLength = 5
+		value -= 30
 		key = NewPhraseFromBits(0, 0, 0, 0)
 	default:
 		panic("input value too large for a cumulative five-bit map")
@@ -270,8 +317,22 @@ func (_ _fiveCumulative) Encode(value int) (key Phrase, projection Phrase) {
 //	0 0 0 0 |      6     |   1 - 64    |      2ⁿ - 1
 //
 // @formatter:on
-func (_ _power) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne(4)
+func (p _power) Read(data Phrase) (value int, remainder Phrase) {
+	power, remainder := p.ReadPower(data)
+	return p.Interpret(power), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (p _power) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	value, remainder = p.Read(data)
+	return value, remainder, fuzzyMapShort(data, 2, 3, 4, 5, 6)
+}
+
+// ReadPower parses the exponent from the next bits in the provided phrase, rather than its 2ⁿ - 1 interpretation.
+//
+// This is the direct inverse of Encode.  See Read for the map.
+func (_ _power) ReadPower(data Phrase) (power int, remainder Phrase) {
+	zeros, remainder := readFuzzyKey(data, 4)
 	var projectionRange int
 
 	switch zeros {
@@ -288,9 +349,24 @@ func (_ _power) Read(data Phrase) (value int, remainder Phrase) {
 	}
 
 	projection, remainder, _ := remainder.Read(projectionRange)
-	power := To.Number(projectionRange, projection.Bits()...)
-	power += 1
-	return 1<<power - 1, remainder
+	power = To.Number(projectionRange, projection.Bits()...)
+	return power + 1, remainder
+}
+
+// Interpret returns the value the provided power projects to using the Fuzzy.Power ZLE Map's 2ⁿ - 1 interpretation.
+func (_ _power) Interpret(power int) int {
+	return 1<<power - 1
+}
+
+// Read parses the power from the next bits in the provided phrase.  See Fuzzy.Power.ReadPower.
+func (p _powers) Read(data Phrase) (power int, remainder Phrase) {
+	return p.ReadPower(data)
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (p _powers) ReadStrict(data Phrase) (power int, remainder Phrase, err error) {
+	power, remainder = p.ReadPower(data)
+	return power, remainder, fuzzyMapShort(data, 2, 3, 4, 5, 6)
 }
 
 // Encode uses the below map to encode a ZLE key and projection from the provided value.
@@ -315,72 +391,155 @@ func (_ _power) Encode(power int) (key Phrase, projection Phrase) {
 
 	switch {
 	case power < 1<<2:
-		bitLength = 1
+		bitLength = 2
 		key = NewPhraseFromBits(1)
 	case power < 1<<3:
-		bitLength = 2
+		bitLength = 3
 		key = NewPhraseFromBits(0, 1)
 	case power < 1<<4:
-		bitLength = 3
+		bitLength = 4
 		key = NewPhraseFromBits(0, 0, 1)
 	case power < 1<<5:
-		bitLength = 4
+		bitLength = 5
 		key = NewPhraseFromBits(0, 0, 0, 1)
 	case power < 1<<6:
-		bitLength = 5
+		bitLength = 6
 		key = NewPhraseFromBits(0, 0, 0, 0)
 	default:
-		panic("input value too large for a five-bit map")
+		panic("input value too large for a power map")
 	}
 
 	return key, NewPhraseFromBits(From.Number(power, bitLength)...)
 }
 
+// DefaultZLEMaxKey is the longest key, in zeros, Fuzzy.ZLE will read unless given another limit - allowing
+// projections of up to 2¹⁶ bits.
+const DefaultZLEMaxKey = 16
+
+// WithMaxKey creates a ZLE map which refuses to read keys of more than the provided number of zeros, guarding
+// against untrusted data asking for an enormous projection.
+//
+// NOTE: This will panic if given a negative limit.
+func (_ _zle) WithMaxKey(zeros int) _zle {
+	if zeros < 0 {
+		panic("cannot limit a ZLE key to a negative length")
+	}
+	return _zle{maxKey: zeros, limited: true}
+}
+
+// limit returns the longest key the map will read or encode.
+func (z _zle) limit() int {
+	if z.limited {
+		return z.maxKey
+	}
+	return DefaultZLEMaxKey
+}
+
 // Read uses the below map to parse a value from the next bits in the provided phrase:
 //
 // @formatter:off
 //
 // The Fuzzy.ZLE Map
 //
-//	NOTE: This will overflow if you let it read too far =)
-//
-//	        Key | Projection
-//	          1 | 1 [2⁰]
-//	        0 1 | 2 [2¹]
-//	      0 0 1 | 4 [2²]
-//	    0 0 0 1 | 8 [2³]
-//	           ...
-//	      𝑛   1 | 2ⁿ
+//	    Key | Projection
+//	      1 | 1 [2⁰]
+//	    0 1 | 2 [2¹]
+//	  0 0 1 | 4 [2²]
+//	0 0 0 1 | 8 [2³]
+//	       ...
+//	  𝑛   1 | 2ⁿ
 //
 // @formatter:on
-func (_ _zle) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne()
-	projectionRange := 1 << zeros
-	projection, remainder, _ := remainder.Read(projectionRange)
-	return To.Number(projectionRange, projection.Bits()...), remainder
+//
+// NOTE: If the value doesn't fit in an int, this returns 0 and the unread data - use ReadBig to read it whole.
+func (z _zle) Read(data Phrase) (value int, remainder Phrase) {
+	value, remainder, _ = z.ReadStrict(data)
+	return value, remainder
 }
 
-// Encode uses the below map to encode a ZLE key and projection from the provided value.
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, wrapping ErrorFuzzyKey if the key is longer than the map's limit, or wrapping ErrorFuzzyOverflow
+// if the value doesn't fit in an int - see ReadBig.
+func (z _zle) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	v, remainder, err := z.readBig(data)
+	if !fitsInt(v) {
+		if err == nil {
+			err = fmt.Errorf("%w: %d bits wide - use ReadBig instead", ErrorFuzzyOverflow, v.BitLen())
+		}
+		return 0, data, err
+	}
+	return int(v.Int64()), remainder, err
+}
+
+// ReadBig parses a value of any size from the next bits in the provided phrase.  See Read.
 //
-// NOTE: This always returns an empty projection.
+// NOTE: If the key is longer than the map's limit, this returns 0 and the unread data.
+func (z _zle) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = z.readBig(data)
+	return value, remainder
+}
+
+func (z _zle) readBig(data Phrase) (value *big.Int, remainder Phrase, err error) {
+	value, remainder, err = readCursor(data, func(c *bitCursor) *big.Int {
+		zeros := 0
+		for zeros <= z.limit() && c.more() && c.next() == Zero {
+			zeros++
+		}
+		if zeros > z.limit() {
+			return nil
+		}
+
+		v := new(big.Int)
+		for i := 0; i < 1<<zeros && c.more(); i++ {
+			v.Lsh(v, 1)
+			v.SetBit(v, 0, uint(c.next()))
+		}
+		return v
+	})
+	if value == nil {
+		return new(big.Int), data, fmt.Errorf("%w: the key is longer than %d zeros", ErrorFuzzyKey, z.limit())
+	}
+	return value, remainder, err
+}
+
+// Encode uses the below map to encode a ZLE key and projection from the provided value, choosing the
+// shortest projection able to hold it.
 //
 // @formatter:off
 //
 // The Fuzzy.ZLE Map
 //
-//	NOTE: This will overflow if you let it read too far =)
-//
-//	        Key | Projection
-//	          1 | 1 [2⁰]
-//	        0 1 | 2 [2¹]
-//	      0 0 1 | 4 [2²]
-//	    0 0 0 1 | 8 [2³]
-//	           ...
-//	      𝑛   1 | 2ⁿ
+//	    Key | Projection
+//	      1 | 1 [2⁰]
+//	    0 1 | 2 [2¹]
+//	  0 0 1 | 4 [2²]
+//	0 0 0 1 | 8 [2³]
+//	       ...
+//	  𝑛   1 | 2ⁿ
 //
 // @formatter:on
-func (_ _zle) Encode(power int) (key Phrase, projection Phrase) {
-	return Synthesize.Zeros(power).AppendBits(1), Phrase{}
+//
+// NOTE: This will panic if given a negative value.
+func (z _zle) Encode(value int) (key Phrase, projection Phrase) {
+	return z.EncodeBig(big.NewInt(int64(value)))
+}
+
+// EncodeBig emits a ZLE key and projection for a value of any size.  See Encode.
+//
+// NOTE: This will panic if given a negative value, or one too wide for the map's key limit.
+func (z _zle) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	if value.Sign() < 0 {
+		panic("cannot ZLE encode a negative value")
+	}
+
+	zeros := 0
+	for 1<<zeros < value.BitLen() {
+		zeros++
+	}
+	if zeros > z.limit() {
+		panic(fmt.Sprintf("input value of %d bits exceeds the ZLE key limit of %d", value.BitLen(), z.limit()))
+	}
+	return Synthesize.Zeros(zeros).AppendBits(One), NewPhraseFromBits(From.BigInt(value, 1<<zeros)...)
 }
 
 // Read uses the below map to parse a value from the next bits in the provided phrase:
@@ -394,7 +553,7 @@ func (_ _zle) Encode(power int) (key Phrase, projection Phrase) {
 //	0 0 0 1 | 6
 //	0 0 0 0 | 8
 func (_ _byte) Read(data Phrase) (value int, remainder Phrase) {
-	zeros, remainder := data.ReadUntilOne(4)
+	zeros, remainder := readFuzzyKey(data, 4)
 	var projectionRange int
 
 	switch zeros {
@@ -414,6 +573,12 @@ func (_ _byte) Read(data Phrase) (value int, remainder Phrase) {
 	return To.Number(projectionRange, projection.Bits()...), remainder
 }
 
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (f _byte) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	value, remainder = f.Read(data)
+	return value, remainder, fuzzyMapShort(data, 2, 3, 4, 6, 8)
+}
+
 // Encode uses the below map to encode a ZLE key and projection from the provided value.
 //
 // @formatter:off
@@ -431,22 +596,55 @@ func (_ _byte) Read(data Phrase) (value int, remainder Phrase) {
 func (_ _byte) Encode(value int) (key Phrase, projection Phrase) {
 	var bitLength int
 	switch {
-	case value < 1<<4:
+	case value < 1<<2:
 		bitLength = 2
 		key = NewPhraseFromBits(1)
-	case value < 1<<8:
+	case value < 1<<3:
 		bitLength = 3
 		key = NewPhraseFromBits(0, 1)
-	case value < 1<<16:
+	case value < 1<<4:
 		bitLength = 4
 		key = NewPhraseFromBits(0, 0, 1)
-	case value < 1<<32:
+	case value < 1<<6:
 		bitLength = 6
 		key = NewPhraseFromBits(0, 0, 0, 1)
-	default:
+	case value < 1<<8:
 		bitLength = 8
 		key = NewPhraseFromBits(0, 0, 0, 0)
+	default:
+		panic("input value too large for a byte map")
 	}
 
 	return key, NewPhraseFromBits(From.Number(value, bitLength)...)
 }
+
+// readFuzzyKey reads a ZLE key of up to the provided limit of zeros from the data, consuming the terminating
+// one if the key ends before reaching the limit.
+func readFuzzyKey(data Phrase, limit int) (zeros int, remainder Phrase) {
+	zeros, remainder = data.ReadUntilOne(limit)
+	if zeros < limit {
+		_, remainder, _ = remainder.ReadNextBit()
+	}
+	return zeros, remainder
+}
+
+// fuzzyMapShort returns an error wrapping ErrorEndOfBits if the data is too short to hold the key and projection
+// of a map whose key is up to len(ranges) - 1 zeros, terminated by a one unless it's the longest key, and whose
+// projection widths are the ranges of each key.
+func fuzzyMapShort(data Phrase, ranges ...int) error {
+	bits := data.Bits()
+	limit := len(ranges) - 1
+	zeros := 0
+	for zeros < limit && zeros < len(bits) && bits[zeros] == Zero {
+		zeros++
+	}
+
+	keyLength := zeros
+	if zeros < limit {
+		keyLength++
+	}
+	if needed := keyLength + ranges[zeros]; len(bits) < needed {
+		return fmt.Errorf("%w: the value needs %d bits, but only %d remain", ErrorEndOfBits, needed, len(bits))
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package tiny
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// FuzzyCandidate names a fuzzy map for Analyze.BestFuzzy to consider.
+type FuzzyCandidate struct {
+	Name  string
+	Codec FuzzyCodec
+}
+
+// FuzzyCost is the exact cost of encoding a set of values with a FuzzyCandidate.
+type FuzzyCost struct {
+	FuzzyCandidate
+
+	// Bits is the total number of bits every representable value encoded to.
+	Bits int
+
+	// Unrepresentable holds every distinct value the candidate couldn't round trip.
+	Unrepresentable []int
+}
+
+// Representable reports if the candidate round tripped every value.
+func (c FuzzyCost) Representable() bool {
+	return len(c.Unrepresentable) == 0
+}
+
+// FuzzyCandidates returns the built-in fuzzy maps that Analyze.BestFuzzy considers by default.  Each reads
+// back exactly the values it encodes, though not every map can encode every non-negative value - Fuzzy.Five,
+// for instance, stops at 31 and the Elias codes begin at 1.
+//
+// NOTE: Fuzzy.Power is left out as it reads its powers back as 2ⁿ - 1 and so round trips almost no values,
+// while Fuzzy.Unary is left out as its cost grows with the values themselves.
+func FuzzyCandidates() []FuzzyCandidate {
+	return []FuzzyCandidate{
+		{"SixtyFour", Fuzzy.SixtyFour},
+		{"Five", Fuzzy.Five},
+		{"FiveCumulative", Fuzzy.FiveCumulative},
+		{"Byte", Fuzzy.Byte},
+		{"ZLE", Fuzzy.ZLE},
+		{"Gamma", Fuzzy.Gamma},
+		{"Delta", Fuzzy.Delta},
+		{"Omega", Fuzzy.Omega},
+		{"Fibonacci", Fuzzy.Fibonacci},
+		{"ExpGolomb", Fuzzy.ExpGolomb(0)},
+	}
+}
+
+// FuzzyCost calculates the exact number of bits the candidate encodes the values into.
+//
+// Every distinct value is round tripped through the candidate, and any value which doesn't read back
+// exactly - or which panics - is reported as unrepresentable rather than counted.
+func (_ _analyze) FuzzyCost(values []int, candidate FuzzyCandidate) FuzzyCost {
+	cost := FuzzyCost{FuzzyCandidate: candidate}
+	widths := make(map[int]int)
+	for _, v := range values {
+		width, ok := widths[v]
+		if !ok {
+			m, represented := verifyFuzzyValue(candidate.Codec.Encode, candidate.Codec.Read, v)
+			width = m.Key.BitLength() + m.Projection.BitLength()
+			if !represented {
+				width = -1
+				cost.Unrepresentable = append(cost.Unrepresentable, v)
+			}
+			widths[v] = width
+		}
+		if width >= 0 {
+			cost.Bits += width
+		}
+	}
+	return cost
+}
+
+// BestFuzzy ranks the candidates by the exact number of bits they encode the values into, cheapest first.
+//
+// Candidates which can't represent every value are ranked after those which can, by how many distinct
+// values they failed.  If no candidates are provided, the FuzzyCandidates are ranked.
+//
+// See FitFuzzy to build a scheme tailored to the values.
+func (a _analyze) BestFuzzy(values []int, candidates ...FuzzyCandidate) []FuzzyCost {
+	if len(candidates) == 0 {
+		candidates = FuzzyCandidates()
+	}
+
+	costs := make([]FuzzyCost, len(candidates))
+	for i, candidate := range candidates {
+		costs[i] = a.FuzzyCost(values, candidate)
+	}
+	slices.SortStableFunc(costs, func(x, y FuzzyCost) int {
+		if c := cmp.Compare(len(x.Unrepresentable), len(y.Unrepresentable)); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.Bits, y.Bits)
+	})
+	return costs
+}
+
+// FitFuzzy builds the fuzzy scheme of up to the provided number of rows which encodes the values into
+// the fewest bits.
+//
+// Every row covers a contiguous range of values and is given a standard ZLE key, with the shortest keys
+// going to the rows which cover the most values.  A row begins at the lowest value the rows before it
+// haven't covered, so the scheme skips any gaps between clusters of values and negative values are
+// welcome.  Every width of every row is searched, pruning any combination which can't beat the best so far.
+//
+// NOTE: The search is exhaustive, so its cost grows exponentially with the number of rows.
+//
+// NOTE: The scheme only represents the ranges its rows cover - values falling in a gap between them
+// can't be encoded.
+//
+// NOTE: This returns an error if there are no values, if rows is less than 1, or if the values span
+// more than your architecture can address.
+func (_ _analyze) FitFuzzy(values []int, rows int) (FuzzyScheme, error) {
+	if len(values) == 0 {
+		return FuzzyScheme{}, fmt.Errorf("%w: cannot fit a scheme to no values", ErrorFuzzyScheme)
+	}
+	if rows < 1 {
+		return FuzzyScheme{}, fmt.Errorf("%w: cannot fit a scheme of %d rows", ErrorFuzzyScheme, rows)
+	}
+
+	sorted := slices.Sorted(slices.Values(values))
+	low, high := sorted[0], sorted[len(sorted)-1]
+	if high-low < 0 || high-low >= math.MaxInt/2 {
+		return FuzzyScheme{}, fmt.Errorf("%w: the values span too wide a range to fit", ErrorFuzzyScheme)
+	}
+
+	// countBelow returns how many values are less than the provided value
+	countBelow := func(value int) int {
+		i, _ := slices.BinarySearch(sorted, value)
+		return i
+	}
+
+	best := math.MaxInt
+	var bestRows []FuzzyRow
+	for r := 1; r <= rows; r++ {
+		keyLength := func(rank int) int {
+			if rank == r-1 {
+				return r - 1
+			}
+			return rank + 1
+		}
+		shortestKey := keyLength(0)
+
+		widths, offsets, counts := make([]int, r), make([]int, r), make([]int, r)
+
+		// fit ranks the rows by how many values they cover, giving the most covered the shortest keys
+		fit := func() {
+			order := make([]int, r)
+			for i := range order {
+				order[i] = i
+			}
+			slices.SortStableFunc(order, func(a, b int) int {
+				return cmp.Compare(counts[b], counts[a])
+			})
+
+			total := 0
+			for rank, row := range order {
+				total += counts[row] * (keyLength(rank) + widths[row])
+			}
+			if total < best {
+				best = total
+				bestRows = make([]FuzzyRow, r)
+				for rank, row := range order {
+					bestRows[rank] = FuzzyRow{Width: widths[row], Offset: offsets[row]}
+				}
+			}
+		}
+
+		var search func(row int, covered int, bound int)
+		search = func(row int, covered int, bound int) {
+			start := sorted[covered]
+			offsets[row] = start
+
+			// The final row covers every remaining value with the narrowest width which reaches the highest
+			if row == r-1 {
+				widths[row] = bits.Len(uint(high - start))
+				counts[row] = len(sorted) - covered
+				if bound+counts[row]*(shortestKey+widths[row]) < best {
+					fit()
+				}
+				return
+			}
+
+			// Any width which covers no more values than a narrower width is skipped, as is any width
+			// which covers every value - that's a scheme of fewer rows
+			previous := covered
+			for width := 0; width < bits.UintSize-1; width++ {
+				end := start + 1<<width
+				if end > high || end < start {
+					return
+				}
+				next := countBelow(end)
+				if next == previous && width > 0 {
+					continue
+				}
+				previous = next
+
+				widths[row] = width
+				counts[row] = next - covered
+				if total := bound + counts[row]*(shortestKey+width); total < best {
+					search(row+1, next, total)
+				}
+			}
+		}
+		search(0, 0, 0)
+	}
+
+	return Fuzzy.NewScheme(FuzzyTable{Rows: bestRows})
+}
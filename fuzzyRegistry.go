@@ -0,0 +1,183 @@
+package tiny
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// FuzzyStreamVersion is the current version of the fuzzy stream header.
+const FuzzyStreamVersion = 1
+
+// FuzzyID identifies a fuzzy map within a stream header.
+//
+// NOTE: The built-in IDs are part of the stream format and will never change.
+type FuzzyID uint
+
+const (
+	// FuzzyIDSixtyFour identifies Fuzzy.SixtyFour.
+	FuzzyIDSixtyFour FuzzyID = iota + 1
+
+	// FuzzyIDFive identifies Fuzzy.Five.
+	FuzzyIDFive
+
+	// FuzzyIDFiveCumulative identifies Fuzzy.FiveCumulative.
+	FuzzyIDFiveCumulative
+
+	// FuzzyIDPower identifies Fuzzy.Powers, whose streams are decoded as the powers they were encoded from.
+	FuzzyIDPower
+
+	// FuzzyIDZLE identifies Fuzzy.ZLE.
+	FuzzyIDZLE
+
+	// FuzzyIDByte identifies Fuzzy.Byte.
+	FuzzyIDByte
+
+	// FuzzyIDUnary identifies Fuzzy.Unary.
+	FuzzyIDUnary
+
+	// FuzzyIDGamma identifies Fuzzy.Gamma.
+	FuzzyIDGamma
+
+	// FuzzyIDDelta identifies Fuzzy.Delta.
+	FuzzyIDDelta
+
+	// FuzzyIDOmega identifies Fuzzy.Omega.
+	FuzzyIDOmega
+
+	// FuzzyIDFibonacci identifies Fuzzy.Fibonacci.
+	FuzzyIDFibonacci
+)
+
+// FuzzyIDCustom is the first ID available to custom maps registered with Fuzzy.Register.
+const FuzzyIDCustom FuzzyID = 1024
+
+var fuzzyRegistry = struct {
+	sync.RWMutex
+	codecs map[FuzzyID]FuzzyCodec
+}{
+	codecs: map[FuzzyID]FuzzyCodec{
+		FuzzyIDSixtyFour:      Fuzzy.SixtyFour,
+		FuzzyIDFive:           Fuzzy.Five,
+		FuzzyIDFiveCumulative: Fuzzy.FiveCumulative,
+		FuzzyIDPower:          Fuzzy.Powers,
+		FuzzyIDZLE:            Fuzzy.ZLE,
+		FuzzyIDByte:           Fuzzy.Byte,
+		FuzzyIDUnary:          Fuzzy.Unary,
+		FuzzyIDGamma:          Fuzzy.Gamma,
+		FuzzyIDDelta:          Fuzzy.Delta,
+		FuzzyIDOmega:          Fuzzy.Omega,
+		FuzzyIDFibonacci:      Fuzzy.Fibonacci,
+	},
+}
+
+// UnknownFuzzyIDError is returned when a stream names a fuzzy map which hasn't been registered.
+type UnknownFuzzyIDError struct {
+	ID FuzzyID
+}
+
+func (e UnknownFuzzyIDError) Error() string {
+	return fmt.Sprintf("unknown fuzzy map ID %d", e.ID)
+}
+
+// Register assigns an ID to a custom fuzzy map, such as a FuzzyScheme or a Golomb code, so streams
+// encoded with it can be decoded by Fuzzy.DecodeStream.
+//
+// NOTE: This returns an error wrapping ErrorFuzzyRegistry if the ID is below FuzzyIDCustom or is already taken.
+func (_ _fuzzy) Register(id FuzzyID, codec FuzzyCodec) error {
+	if id < FuzzyIDCustom {
+		return fmt.Errorf("%w: ID %d is reserved for built-in maps", ErrorFuzzyRegistry, id)
+	}
+	if codec == nil {
+		return fmt.Errorf("%w: cannot register a nil map", ErrorFuzzyRegistry)
+	}
+
+	fuzzyRegistry.Lock()
+	defer fuzzyRegistry.Unlock()
+	if _, ok := fuzzyRegistry.codecs[id]; ok {
+		return fmt.Errorf("%w: ID %d is already registered", ErrorFuzzyRegistry, id)
+	}
+	fuzzyRegistry.codecs[id] = codec
+	return nil
+}
+
+// Lookup returns the fuzzy map registered to the ID and whether it exists.
+func (_ _fuzzy) Lookup(id FuzzyID) (FuzzyCodec, bool) {
+	fuzzyRegistry.RLock()
+	defer fuzzyRegistry.RUnlock()
+	codec, ok := fuzzyRegistry.codecs[id]
+	return codec, ok
+}
+
+// FuzzyStreamHeader describes the values which follow it in a fuzzy stream.
+type FuzzyStreamHeader struct {
+	Version int
+	ID      FuzzyID
+	Count   int
+}
+
+// EncodeStream encodes the values using the fuzzy map registered to the ID, led by a stream header.
+//
+// @formatter:off
+//
+// Each field of the header is an unsigned LEB128 -
+//
+//	| Version | ID  | Count | Values |
+//	|   ...   | ... |  ...  |  ...   |
+//
+// @formatter:on
+//
+// NOTE: This returns an UnknownFuzzyIDError if the ID hasn't been registered.
+func (f _fuzzy) EncodeStream(id FuzzyID, values []int) (Phrase, error) {
+	codec, ok := f.Lookup(id)
+	if !ok {
+		return nil, UnknownFuzzyIDError{ID: id}
+	}
+
+	header := From.ULEB128(big.NewInt(FuzzyStreamVersion))
+	header = header.Append(From.ULEB128(new(big.Int).SetUint64(uint64(id))))
+	header = header.Append(From.ULEB128(big.NewInt(int64(len(values)))))
+	return header.Append(f.EncodeAll(codec, values)), nil
+}
+
+// ReadStreamHeader reads a fuzzy stream header from the start of the phrase, followed by the remainder.
+//
+// NOTE: This returns an error wrapping ErrorFuzzyStream if the header is malformed or of an unsupported version.
+func (_ _fuzzy) ReadStreamHeader(data Phrase) (header FuzzyStreamHeader, remainder Phrase, err error) {
+	remainder = data
+	fields := make([]int, 3)
+	for i := range fields {
+		var value *big.Int
+		value, remainder, err = To.ULEB128(remainder)
+		if err != nil {
+			return FuzzyStreamHeader{}, data, fmt.Errorf("%w: %w", ErrorFuzzyStream, err)
+		}
+		if !value.IsInt64() || value.Int64() > int64(MaxCadence) {
+			return FuzzyStreamHeader{}, data, fmt.Errorf("%w: header field %d is too large", ErrorFuzzyStream, i)
+		}
+		fields[i] = int(value.Int64())
+	}
+
+	header = FuzzyStreamHeader{Version: fields[0], ID: FuzzyID(fields[1]), Count: fields[2]}
+	if header.Version != FuzzyStreamVersion {
+		return FuzzyStreamHeader{}, data, fmt.Errorf("%w: unsupported version %d", ErrorFuzzyStream, header.Version)
+	}
+	return header, remainder, nil
+}
+
+// DecodeStream reads a stream written by EncodeStream, picking the fuzzy map from the header's ID, and
+// returns the header's count of values followed by the remainder.
+//
+// NOTE: This returns an UnknownFuzzyIDError if the header's ID hasn't been registered, and otherwise
+// reports errors as Fuzzy.DecodeAll does.
+func (f _fuzzy) DecodeStream(data Phrase) (values []int, remainder Phrase, err error) {
+	header, remainder, err := f.ReadStreamHeader(data)
+	if err != nil {
+		return nil, data, err
+	}
+	codec, ok := f.Lookup(header.ID)
+	if !ok {
+		return nil, data, UnknownFuzzyIDError{ID: header.ID}
+	}
+	return decodeFuzzy(codec, remainder, header.Count)
+}
@@ -0,0 +1,219 @@
+package tiny
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// FuzzyRow describes a single key of a fuzzy ZLE map and how its projection is interpreted.
+type FuzzyRow struct {
+	// Key is the bits which identify this row of the map.
+	//
+	// NOTE: If nil, a standard ZLE key is generated from the row's index - see FuzzyTable.
+	Key []Bit
+
+	// Width is the bit width of the row's projection.
+	Width int
+
+	// Offset is added to the projected value when reading, and removed when encoding.
+	//
+	// NOTE: Cumulative tables calculate every offset after the first row's automatically.
+	Offset int
+
+	// Transform optionally interprets the offset projection as a value when reading.
+	//
+	// NOTE: Transform must be monotonic and is required to be paired with an Inverse.
+	Transform func(int) int
+
+	// Inverse reverses the Transform when encoding.
+	Inverse func(int) int
+}
+
+// FuzzyTable declares the rows of a fuzzy ZLE map, which Fuzzy.NewScheme builds into a FuzzyScheme.
+//
+// Any row without an explicit key is given a standard ZLE key - its index in zeros followed by a one,
+// except for the final row, which is only zeros.
+//
+// @formatter:off
+//
+// For example, the Fuzzy.Five ZLE Map can be declared as:
+//
+//	tiny.FuzzyTable{
+//		Rows: []tiny.FuzzyRow{
+//			{ Width: 1 }, // 1
+//			{ Width: 2 }, // 0 1
+//			{ Width: 3 }, // 0 0 1
+//			{ Width: 4 }, // 0 0 0 1
+//			{ Width: 5 }, // 0 0 0 0
+//		},
+//	}
+//
+// While the cumulative Fuzzy.FiveCumulative ZLE Map only needs the table to be flagged as cumulative.
+//
+// @formatter:on
+type FuzzyTable struct {
+	// Rows are the keys of the map, in order of preference when encoding.
+	Rows []FuzzyRow
+
+	// Cumulative offsets every row to begin where the previous row's range ended.
+	Cumulative bool
+}
+
+// FuzzyScheme is a fuzzy ZLE map built from a FuzzyTable, providing matching encode and read functions.
+type FuzzyScheme struct {
+	rows      []FuzzyRow
+	keyLength int
+	min       int
+	max       int
+}
+
+// NewScheme builds a FuzzyScheme from the provided table.
+//
+// NOTE: This returns an error if the table is empty, if any projection width is negative or too wide to hold
+// in an int, if any row's range overflows an int, if a Transform isn't paired with an Inverse, or if the keys
+// don't form a prefix-free set.
+func (_ _fuzzy) NewScheme(table FuzzyTable) (FuzzyScheme, error) {
+	if len(table.Rows) == 0 {
+		return FuzzyScheme{}, fmt.Errorf("%w: a scheme requires at least one row", ErrorFuzzyScheme)
+	}
+
+	s := FuzzyScheme{rows: make([]FuzzyRow, len(table.Rows))}
+	for i, row := range table.Rows {
+		if row.Width < 0 || row.Width >= GetArchitectureBitWidth()-1 {
+			return FuzzyScheme{}, fmt.Errorf("%w: row %d has an invalid projection width of %d", ErrorFuzzyScheme, i, row.Width)
+		}
+		if (row.Transform == nil) != (row.Inverse == nil) {
+			return FuzzyScheme{}, fmt.Errorf("%w: row %d must provide both a transform and its inverse", ErrorFuzzyScheme, i)
+		}
+
+		if row.Key == nil {
+			row.Key = Synthesize.Zeros(i).Bits()
+			if i < len(table.Rows)-1 {
+				row.Key = append(row.Key, One)
+			}
+		} else {
+			row.Key = slices.Clone(row.Key)
+		}
+
+		if table.Cumulative && i > 0 {
+			previous := s.rows[i-1]
+			if previous.Offset+previous.limit() == math.MaxInt {
+				return FuzzyScheme{}, fmt.Errorf("%w: row %d's offset overflows an int", ErrorFuzzyScheme, i)
+			}
+			row.Offset = previous.Offset + previous.limit() + 1
+		}
+		if row.Offset > math.MaxInt-row.limit() {
+			return FuzzyScheme{}, fmt.Errorf("%w: row %d's range overflows an int", ErrorFuzzyScheme, i)
+		}
+
+		s.rows[i] = row
+		s.keyLength = max(s.keyLength, len(row.Key))
+	}
+
+	for i, a := range s.rows {
+		for ii, b := range s.rows {
+			if i != ii && Analyze.HasPrefix(b.Key, a.Key...) {
+				return FuzzyScheme{}, fmt.Errorf("%w: the key of row %d prefixes the key of row %d", ErrorFuzzyScheme, i, ii)
+			}
+		}
+	}
+
+	for i, row := range s.rows {
+		low, high := row.interpret(0), row.interpret(row.limit())
+		low, high = min(low, high), max(low, high)
+		if i == 0 {
+			s.min, s.max = low, high
+		}
+		s.min, s.max = min(s.min, low), max(s.max, high)
+	}
+
+	return s, nil
+}
+
+// interpret converts a projected value into the value it represents.
+func (r FuzzyRow) interpret(projected int) int {
+	value := projected + r.Offset
+	if r.Transform != nil {
+		value = r.Transform(value)
+	}
+	return value
+}
+
+// project converts a value into the projection it's represented by, and whether it fits within the row.
+func (r FuzzyRow) project(value int) (int, bool) {
+	projected := value
+	if r.Inverse != nil {
+		projected = r.Inverse(value)
+	}
+	projected -= r.Offset
+	if projected < 0 || projected > r.limit() || r.interpret(projected) != value {
+		return 0, false
+	}
+	return projected, true
+}
+
+// limit returns the largest value the row's projection can hold.
+func (r FuzzyRow) limit() int {
+	return 1<<r.Width - 1
+}
+
+// Range returns the lowest and highest values the scheme can represent.
+//
+// NOTE: Non-cumulative schemes may not be able to represent every value in between.
+func (s FuzzyScheme) Range() (low int, high int) {
+	return s.min, s.max
+}
+
+// Funcs returns the scheme's matching encode and read functions.
+func (s FuzzyScheme) Funcs() (FuzzyEncodeFunc, FuzzyReadFunc) {
+	return s.Encode, s.Read
+}
+
+// Encode emits the key and projection of the first row able to represent the provided value.
+//
+// NOTE: This will panic if no row of the scheme can represent the value.
+func (s FuzzyScheme) Encode(value int) (key Phrase, projection Phrase) {
+	for _, row := range s.rows {
+		if projected, ok := row.project(value); ok {
+			return NewPhraseFromBits(row.Key...), NewPhraseFromBits(From.Number(projected, row.Width)...)
+		}
+	}
+	panic(fmt.Sprintf("input value %d cannot be represented by this scheme", value))
+}
+
+// Read parses a value from the next bits in the provided phrase using the row identified by the key.
+//
+// NOTE: If the next bits don't match any key, this returns 0 and the unread data.
+func (s FuzzyScheme) Read(data Phrase) (value int, remainder Phrase) {
+	value, remainder, _ = s.ReadStrict(data)
+	return value, remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the key or projection runs past
+// the end of the data, or wrapping ErrorFuzzyKey if the next bits don't match any key.
+func (s FuzzyScheme) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	head, _, _ := data.Read(s.keyLength)
+	bits := head.Bits()
+
+	for _, row := range s.rows {
+		if !Analyze.HasPrefix(bits, row.Key...) {
+			continue
+		}
+		var projection Phrase
+		_, remainder, _ = data.Read(len(row.Key))
+		projection, remainder, err = remainder.Read(row.Width)
+		if err != nil {
+			err = fmt.Errorf("%w: the projection needs %d bits, but only %d remain", ErrorEndOfBits, row.Width, projection.BitLength())
+		}
+		return row.interpret(To.Number(row.Width, projection.Bits()...)), remainder, err
+	}
+
+	// Bits which begin a key, but run out before it ends, were cut short
+	for _, row := range s.rows {
+		if len(bits) < len(row.Key) && Analyze.HasPrefix(row.Key, bits...) {
+			return 0, data, fmt.Errorf("%w: the key is cut short after %d bits", ErrorEndOfBits, len(bits))
+		}
+	}
+	return 0, data, ErrorFuzzyKey
+}
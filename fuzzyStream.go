@@ -0,0 +1,63 @@
+package tiny
+
+import "fmt"
+
+// EncodeAll encodes every value using the provided scheme and concatenates each key and projection
+// into a single aligned phrase.
+func (_ _fuzzy) EncodeAll(scheme FuzzyCodec, values []int) Phrase {
+	bits := make([]Bit, 0, len(values)*8)
+	for _, v := range values {
+		key, projection := scheme.Encode(v)
+		bits = append(bits, key.Bits()...)
+		bits = append(bits, projection.Bits()...)
+	}
+	return NewPhraseFromBits(bits...)
+}
+
+// DecodeAll reads values from the provided phrase using the scheme until every bit has been consumed.
+//
+// NOTE: Values are returned as the scheme reads them - Fuzzy.Power reads back the 2ⁿ - 1 interpretation of
+// every encoded power, so decode with Fuzzy.Powers to get back the powers themselves.
+//
+// NOTE: If the final value is cut short, this returns the values read so far and an error wrapping
+// ErrorEndOfBits.  If the next bits don't match any of the scheme's keys, the error wraps ErrorFuzzyKey.
+// Only a FuzzyStrictCodec can report a value cut short - any other codec's final value is taken as read.
+func (_ _fuzzy) DecodeAll(scheme FuzzyCodec, data Phrase) ([]int, error) {
+	values, _, err := decodeFuzzy(scheme, data, -1)
+	return values, err
+}
+
+// decodeFuzzy reads up to count values from the phrase using the scheme, followed by the remainder.
+// A negative count reads until every bit has been consumed.
+func decodeFuzzy(scheme FuzzyCodec, data Phrase, count int) (values []int, remainder Phrase, err error) {
+	values = make([]int, 0)
+	total := data.BitLength()
+	remainder = data
+	strict, isStrict := scheme.(FuzzyStrictCodec)
+
+	for remainder.BitLength() > 0 && (count < 0 || len(values) < count) {
+		offset := total - remainder.BitLength()
+
+		var value int
+		var next Phrase
+		if isStrict {
+			value, next, err = strict.ReadStrict(remainder)
+			if err != nil {
+				return values, remainder, fmt.Errorf("%w at bit %d", err, offset)
+			}
+		} else {
+			value, next = scheme.Read(remainder)
+		}
+
+		if next.BitLength() == remainder.BitLength() {
+			return values, remainder, fmt.Errorf("%w at bit %d", ErrorFuzzyKey, offset)
+		}
+		values = append(values, value)
+		remainder = next
+	}
+
+	if count >= 0 && len(values) < count {
+		return values, remainder, fmt.Errorf("%w: read %d of %d values", ErrorEndOfBits, len(values), count)
+	}
+	return values, remainder, nil
+}
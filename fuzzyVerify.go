@@ -0,0 +1,133 @@
+package tiny
+
+import "fmt"
+
+// fuzzySentinel is appended after every encoded value during verification to catch reads that consume
+// too few, or too many, bits.
+var fuzzySentinel = []Bit{1, 0, 1, 1, 0, 0, 1, 0}
+
+// FuzzyMismatch describes a value which did not survive a round trip through a fuzzy scheme.
+type FuzzyMismatch struct {
+	// Value is the value which was encoded.
+	Value int
+
+	// Read is the value which was read back.
+	Read int
+
+	// Key is the key the value was encoded with.
+	Key Phrase
+
+	// Projection is the projection the value was encoded with.
+	Projection Phrase
+
+	// Consumed is the number of bits the read consumed, which should match the key and projection's length.
+	Consumed int
+
+	// Err holds why the round trip failed.
+	Err error
+
+	// Seed is the seed the sampled values were drawn from, which replays the check through VerifySeeded.
+	//
+	// NOTE: This is 0 if the range was walked exhaustively.
+	Seed uint64
+}
+
+func (m FuzzyMismatch) String() string {
+	out := fmt.Sprintf("%d [%s %s] -> %d: %v", m.Value, m.Key.StringBinary(), m.Projection.StringBinary(), m.Read, m.Err)
+	if m.Seed != 0 {
+		out += fmt.Sprintf(" (seed %d)", m.Seed)
+	}
+	return out
+}
+
+// Verify checks that every value from min to max (inclusive) survives being encoded and then read back,
+// returning a FuzzyMismatch for every value that doesn't.
+//
+// A round trip is only considered successful if the read returns the original value and consumes exactly
+// the bits of the key and projection - no more, no less.  A panicking encoder or reader is also reported.
+//
+// If you'd prefer to randomly check a wide range rather than walk it exhaustively, provide a sample count.
+// The samples are drawn from a fresh seed, which every mismatch reports so the check can be replayed
+// with VerifySeeded.
+func (f _fuzzy) Verify(encode FuzzyEncodeFunc, read FuzzyReadFunc, min int, max int, samples ...int) []FuzzyMismatch {
+	if len(samples) > 0 && samples[0] > 0 {
+		return f.VerifySeeded(encode, read, min, max, samples[0], NewSynthesizer().Seed())
+	}
+	if min > max {
+		panic("cannot verify a range whose minimum is greater than its maximum")
+	}
+
+	mismatches := make([]FuzzyMismatch, 0)
+	for value := min; ; value++ {
+		if m, ok := verifyFuzzyValue(encode, read, value); !ok {
+			mismatches = append(mismatches, m)
+		}
+		if value == max {
+			break
+		}
+	}
+	return mismatches
+}
+
+// VerifySeeded randomly checks the provided number of samples from min to max (inclusive), drawing them
+// from the provided seed.  The min and max values are always checked.  See Verify.
+//
+// NOTE: This will panic if the minimum is greater than the maximum or fewer than one sample is requested.
+func (_ _fuzzy) VerifySeeded(encode FuzzyEncodeFunc, read FuzzyReadFunc, min int, max int, samples int, seed uint64) []FuzzyMismatch {
+	if min > max {
+		panic("cannot verify a range whose minimum is greater than its maximum")
+	}
+	if samples < 1 {
+		panic("cannot verify fewer than one sample")
+	}
+
+	random := NewSynthesizer(seed).random
+	mismatches := make([]FuzzyMismatch, 0)
+	check := func(value int) {
+		if m, ok := verifyFuzzyValue(encode, read, value); !ok {
+			m.Seed = seed
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	check(min)
+	check(max)
+	span := uint64(max-min) + 1
+	for i := 0; i < samples; i++ {
+		offset := random.Uint64()
+		if span != 0 {
+			offset = random.Uint64N(span)
+		}
+		check(min + int(offset))
+	}
+	return mismatches
+}
+
+// verifyFuzzyValue performs a single round trip of the provided value.
+func verifyFuzzyValue(encode FuzzyEncodeFunc, read FuzzyReadFunc, value int) (m FuzzyMismatch, ok bool) {
+	m.Value = value
+	defer func() {
+		if r := recover(); r != nil {
+			m.Err = fmt.Errorf("panicked: %v", r)
+			ok = false
+		}
+	}()
+
+	m.Key, m.Projection = encode(value)
+	encoded := NewPhraseFromBits(append(append(m.Key.Bits(), m.Projection.Bits()...), fuzzySentinel...)...)
+
+	var remainder Phrase
+	m.Read, remainder = read(encoded)
+	m.Consumed = encoded.BitLength() - remainder.BitLength()
+	expected := m.Key.BitLength() + m.Projection.BitLength()
+
+	switch {
+	case m.Read != value:
+		m.Err = fmt.Errorf("read %d, expected %d", m.Read, value)
+	case m.Consumed != expected:
+		m.Err = fmt.Errorf("consumed %d bits, expected %d", m.Consumed, expected)
+	default:
+		return m, true
+	}
+	return m, false
+}
@@ -0,0 +1,138 @@
+package tiny
+
+// Growth describes how a single level of a recursive pathway should be walked.
+type Growth struct {
+	// Width is the bit width to operate at for this level.
+	Width int
+
+	// Stride is how many bits narrower the next level should be than this level's width.
+	Stride int
+
+	// Movement is the index of the movement to perform at this level.
+	Movement int
+}
+
+// GrowthScheme is the implied set of rules in which a recursive pathway should be walked.
+//
+// At every level of recursion, the scheme is handed the current level and bit width and decides the
+// width, stride, and Movement of that level.  Schemes are intentionally stateless, allowing the same
+// pathway to be re-derived when it's walked in reverse.
+//
+// A Passage walks the width and stride of every level and records its movement in its DNA, while a
+// Composition performs the movement of every level - a composition's widths are the widths its movements
+// produce, so it doesn't consult the width or stride.
+type GrowthScheme interface {
+	// Grow returns the Growth of the provided recursion level, given the current bit width.
+	Grow(level int, width int) Growth
+}
+
+// StandardGrowth walks a pathway one bit at a time within the standard MaxPassage bit width.
+var StandardGrowth = ConstantGrowth{Width: MaxPassage, Stride: 1}
+
+// ConstantGrowth walks every level at the same width and stride.
+//
+// NOTE: If the current width is narrower than the constant width, the current width is used.
+type ConstantGrowth struct {
+	Width    int
+	Stride   int
+	Movement int
+}
+
+// Grow returns the constant Growth, clamped to the current width.
+func (g ConstantGrowth) Grow(level int, width int) Growth {
+	w := g.Width
+	if w <= 0 || w > width {
+		w = width
+	}
+	return Growth{Width: w, Stride: max(g.Stride, 1), Movement: g.Movement}
+}
+
+// HalvingGrowth halves the width at every level.
+type HalvingGrowth struct {
+	Movement int
+}
+
+// Grow returns a Growth whose stride lands the next level at half the current width.
+func (g HalvingGrowth) Grow(level int, width int) Growth {
+	return Growth{Width: width, Stride: max(width-width/2, 1), Movement: g.Movement}
+}
+
+// PowerGrowth snaps every level to the widths interpreted by the Fuzzy.Power ZLE Map - 2ⁿ - 1.
+//
+// @formatter:off
+//
+// For example, starting at a width of 100:
+//
+//	100 → 63 → 31 → 15 → 7 → 3 → 1
+//
+// @formatter:on
+type PowerGrowth struct {
+	Movement int
+}
+
+// Grow returns a Growth at the largest 2ⁿ - 1 width that fits within the current width, striding to the next power down.
+func (g PowerGrowth) Grow(level int, width int) Growth {
+	if width <= 0 {
+		return Growth{Width: 0, Stride: 1, Movement: g.Movement}
+	}
+	power := GetBitWidth(width + 1)
+	if Fuzzy.Power.Interpret(power) > width {
+		power--
+	}
+	w := Fuzzy.Power.Interpret(power)
+	return Growth{Width: w, Stride: max(w-Fuzzy.Power.Interpret(power-1), 1), Movement: g.Movement}
+}
+
+// TableGrowth walks each level using the Growth at the same index of the table.  Once the pathway
+// walks beyond the table, the final entry is used for every remaining level.
+//
+// NOTE: An entry width of 0 or less, or wider than the current width, operates at the current width.
+type TableGrowth []Growth
+
+// Grow returns the table's Growth for the provided level.
+func (g TableGrowth) Grow(level int, width int) Growth {
+	if len(g) == 0 {
+		panic("cannot grow from an empty table")
+	}
+	out := g[min(level, len(g)-1)]
+	if out.Width <= 0 || out.Width > width {
+		out.Width = width
+	}
+	out.Stride = max(out.Stride, 1)
+	return out
+}
+
+// growthWidths walks the provided scheme from the initial width down to the final width and returns the
+// width of every level along the way.
+//
+// NOTE: If no scheme is provided, every width from the initial down to the final is walked.
+func growthWidths(initial int, final int, scheme GrowthScheme) []int {
+	steps := growthSteps(initial, final, scheme)
+	widths := make([]int, len(steps))
+	for i, step := range steps {
+		widths[i] = step.InputWidth
+	}
+	return widths
+}
+
+// growthSteps walks the provided scheme from the initial width down to the final width, recording each
+// level's movement as a step from its width to the width its stride lands on.  See growthWidths.
+func growthSteps(initial int, final int, scheme GrowthScheme) Pathway {
+	steps := make(Pathway, 0, max(initial-final+1, 0))
+	width := initial
+	for level := 0; width >= final; level++ {
+		g := Growth{Width: width, Stride: 1}
+		if scheme != nil {
+			g = scheme.Grow(level, width)
+		}
+		if g.Width <= 0 || g.Width > width {
+			g.Width = width
+		}
+		if g.Width < final {
+			break
+		}
+		width = g.Width - max(g.Stride, 1)
+		steps = append(steps, Step{Movement: g.Movement, InputWidth: g.Width, OutputWidth: max(width, 0)})
+	}
+	return steps
+}
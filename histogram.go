@@ -0,0 +1,93 @@
+package tiny
+
+import (
+	"maps"
+	"math"
+	"slices"
+)
+
+// Histogram is a count of every symbol value found while reading a phrase at a fixed width.
+type Histogram struct {
+	// Width is the bit width of each symbol.
+	Width int
+
+	// Step is how many bits the reading window advanced between symbols.
+	Step int
+
+	// Counts holds how many times each symbol value was found - values which were never found are absent.
+	Counts map[int]int
+
+	// Total is the number of symbols which were read.
+	Total int
+}
+
+// Count returns how many times the symbol value was found.
+func (h Histogram) Count(symbol int) int {
+	return h.Counts[symbol]
+}
+
+// Probability returns the fraction of all symbols which held the symbol value.
+func (h Histogram) Probability(symbol int) float64 {
+	if h.Total == 0 {
+		return 0
+	}
+	return float64(h.Counts[symbol]) / float64(h.Total)
+}
+
+// Symbols returns every symbol value which was found, in ascending order.
+func (h Histogram) Symbols() []int {
+	return slices.Sorted(maps.Keys(h.Counts))
+}
+
+// Histogram counts every symbol value of the provided width within the data.
+//
+// The reading window advances by step bits after each symbol - a step equal to the width reads aligned
+// symbols, while a step of 1 reads every overlapping symbol as a sliding window.  Any trailing bits too
+// few to fill the window are left uncounted.
+//
+// @formatter:off
+//
+//	 Data | 1 0 1 1 0 1
+//	Width | 2
+//
+//	Aligned (step 2) | 10 11 01       → { 1: 1, 2: 1, 3: 1 }
+//	Sliding (step 1) | 10 01 11 10 01 → { 1: 2, 2: 2, 3: 1 }
+//
+// @formatter:on
+//
+// NOTE: This will panic if the width is less than 1 or too wide to hold in an int, or if the step is less than 1.
+func (_ _analyze) Histogram(data Phrase, width int, step int) Histogram {
+	if width < 1 || width >= GetArchitectureBitWidth() {
+		panic("cannot histogram symbols narrower than 1 bit, or too wide to hold in an int")
+	}
+	if step < 1 {
+		panic("cannot advance a histogram's window by less than 1 bit")
+	}
+
+	h := Histogram{Width: width, Step: step, Counts: make(map[int]int)}
+	bits := data.Bits()
+	for i := 0; i+width <= len(bits); i += step {
+		h.Counts[To.Number(width, bits[i:i+width]...)]++
+		h.Total++
+	}
+	return h
+}
+
+// Entropy calculates the Shannon entropy of the histogram's symbols, in bits per symbol and in bits per bit.
+//
+// The entropy per symbol is the fewest bits, on average, that any code can spend on each symbol - while
+// the entropy per bit divides that across the symbol's width, where 1 means the symbols are incompressible.
+//
+// NOTE: An empty histogram has no entropy.
+func (_ _analyze) Entropy(h Histogram) (perSymbol float64, perBit float64) {
+	if h.Total == 0 {
+		return 0, 0
+	}
+	for _, count := range h.Counts {
+		if count > 0 {
+			p := float64(count) / float64(h.Total)
+			perSymbol -= p * math.Log2(p)
+		}
+	}
+	return perSymbol, perSymbol / float64(h.Width)
+}
@@ -0,0 +1,398 @@
+package tiny
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// MaxHuffmanLength is the longest code length a Huffman code will assign, unless a shorter limit is requested.
+const MaxHuffmanLength = 32
+
+// huffmanLookupBits is the widest index of a Huffman code's decoding table.
+const huffmanLookupBits = 10
+
+// Huffman is a canonical Huffman code over symbols of a fixed bit width.
+//
+// Canonical codes are entirely described by each symbol's code length, which keeps their serialized
+// table small - see Table.
+type Huffman struct {
+	width   int
+	symbols []int
+	counts  []int
+	codes   map[int][]Bit
+
+	lookupBits int
+	lookup     []huffmanEntry
+}
+
+// huffmanEntry resolves a symbol from the next lookupBits of data.  A zero length means the code is longer
+// than the table's index and must be walked bit by bit.
+type huffmanEntry struct {
+	symbol int
+	length int
+}
+
+// NewHuffman builds a canonical Huffman code from the frequencies of each symbol of the provided width.
+//
+// Symbols with a frequency of zero are left out of the code.  If a maximum length is provided, the code
+// lengths are limited to it using the package-merge algorithm - otherwise, they're limited to MaxHuffmanLength.
+//
+// NOTE: This returns an error if no symbol has a frequency, if a frequency is negative, if a symbol can't be
+// held by the width, or if the maximum length is too short to give every symbol a code.
+func NewHuffman(width int, frequencies map[int]int, maxLength ...int) (*Huffman, error) {
+	if width < 1 || width >= GetArchitectureBitWidth() {
+		return nil, fmt.Errorf("%w: invalid symbol width %d", ErrorHuffmanTable, width)
+	}
+	limit := MaxHuffmanLength
+	if len(maxLength) > 0 {
+		limit = maxLength[0]
+	}
+	if limit < 1 || limit > MaxHuffmanLength {
+		return nil, fmt.Errorf("%w: invalid maximum code length %d", ErrorHuffmanTable, limit)
+	}
+
+	symbols := make([]int, 0, len(frequencies))
+	for symbol, frequency := range frequencies {
+		if frequency < 0 {
+			return nil, fmt.Errorf("%w: symbol %d has a negative frequency", ErrorHuffmanTable, symbol)
+		}
+		if symbol < 0 || symbol > 1<<width-1 {
+			return nil, fmt.Errorf("%w: symbol %d can't be held in %d bits", ErrorHuffmanTable, symbol, width)
+		}
+		if frequency > 0 {
+			symbols = append(symbols, symbol)
+		}
+	}
+	if len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: no symbol has a frequency", ErrorHuffmanTable)
+	}
+	if len(symbols) > 1<<limit {
+		return nil, fmt.Errorf("%w: %d symbols can't be coded in %d bits", ErrorHuffmanTable, len(symbols), limit)
+	}
+
+	// Sorting first keeps the construction deterministic despite the map
+	slices.Sort(symbols)
+	weights := make([]int, len(symbols))
+	for i, symbol := range symbols {
+		weights[i] = frequencies[symbol]
+	}
+
+	var lengths []int
+	if len(symbols) == 1 {
+		lengths = []int{1}
+	} else {
+		lengths = huffmanLengths(weights)
+		if slices.Max(lengths) > limit {
+			lengths = packageMerge(weights, limit)
+		}
+	}
+
+	counts := make([]int, slices.Max(lengths)+1)
+	for _, length := range lengths {
+		counts[length]++
+	}
+
+	// Canonical order is by code length, then by symbol
+	order := make([]int, len(symbols))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return lengths[a] - lengths[b] })
+	canonical := make([]int, len(symbols))
+	for i, index := range order {
+		canonical[i] = symbols[index]
+	}
+	return newCanonicalHuffman(width, canonical, counts), nil
+}
+
+// NewHuffmanFromHistogram builds a canonical Huffman code from the symbol counts of a histogram - see
+// Analyze.Histogram and NewHuffman.
+//
+// NOTE: This returns an error under the same conditions as NewHuffman.
+func NewHuffmanFromHistogram(histogram Histogram, maxLength ...int) (*Huffman, error) {
+	return NewHuffman(histogram.Width, histogram.Counts, maxLength...)
+}
+
+// newCanonicalHuffman assigns canonical codes to the symbols, which must be in canonical order, from the
+// number of codes of each length.
+func newCanonicalHuffman(width int, symbols []int, counts []int) *Huffman {
+	h := &Huffman{
+		width:   width,
+		symbols: symbols,
+		counts:  counts,
+		codes:   make(map[int][]Bit, len(symbols)),
+	}
+
+	code, i := 0, 0
+	for length := 1; length < len(counts); length++ {
+		for ii := 0; ii < counts[length]; ii++ {
+			h.codes[symbols[i]] = From.Number(code, length)
+			code++
+			i++
+		}
+		code <<= 1
+	}
+
+	h.lookupBits = min(len(counts)-1, huffmanLookupBits)
+	h.lookup = make([]huffmanEntry, 1<<h.lookupBits)
+	for symbol, bits := range h.codes {
+		if len(bits) > h.lookupBits {
+			continue
+		}
+		spare := h.lookupBits - len(bits)
+		start := To.Number(len(bits), bits...) << spare
+		for index := start; index < start+1<<spare; index++ {
+			h.lookup[index] = huffmanEntry{symbol: symbol, length: len(bits)}
+		}
+	}
+	return h
+}
+
+// huffmanLengths calculates the optimal, unlimited, code length of every weight.
+func huffmanLengths(weights []int) []int {
+	n := len(weights)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return weights[a] - weights[b] })
+
+	// Leaves are held in the first n nodes, in ascending weight, while the merged nodes are appended after
+	// them - also in ascending weight - so the two lightest nodes are always at the front of either queue.
+	weight := make([]int, 0, 2*n-1)
+	for _, i := range order {
+		weight = append(weight, weights[i])
+	}
+	parent := make([]int, 2*n-1)
+	leaf, merged := 0, n
+	lightest := func() int {
+		if leaf < n && (merged >= len(weight) || weight[leaf] <= weight[merged]) {
+			leaf++
+			return leaf - 1
+		}
+		merged++
+		return merged - 1
+	}
+	for len(weight) < 2*n-1 {
+		a, b := lightest(), lightest()
+		parent[a], parent[b] = len(weight), len(weight)
+		weight = append(weight, weight[a]+weight[b])
+	}
+
+	depth := make([]int, 2*n-1)
+	for i := 2*n - 3; i >= 0; i-- {
+		depth[i] = depth[parent[i]] + 1
+	}
+	lengths := make([]int, n)
+	for i, original := range order {
+		lengths[original] = depth[i]
+	}
+	return lengths
+}
+
+// mergeItem is either a leaf or a package of two items, as built by packageMerge.
+type mergeItem struct {
+	weight int
+	leaf   int
+	left   *mergeItem
+	right  *mergeItem
+}
+
+// packageMerge calculates the optimal code length of every weight, where no length exceeds the limit.
+func packageMerge(weights []int, limit int) []int {
+	n := len(weights)
+	leaves := make([]*mergeItem, n)
+	for i, w := range weights {
+		leaves[i] = &mergeItem{weight: w, leaf: i}
+	}
+	slices.SortStableFunc(leaves, func(a, b *mergeItem) int { return a.weight - b.weight })
+
+	list := leaves
+	for level := 1; level < limit; level++ {
+		packages := make([]*mergeItem, 0, len(list)/2)
+		for i := 0; i+1 < len(list); i += 2 {
+			packages = append(packages, &mergeItem{weight: list[i].weight + list[i+1].weight, leaf: -1, left: list[i], right: list[i+1]})
+		}
+
+		next := make([]*mergeItem, 0, n+len(packages))
+		l, p := 0, 0
+		for l < n || p < len(packages) {
+			if p >= len(packages) || (l < n && leaves[l].weight <= packages[p].weight) {
+				next = append(next, leaves[l])
+				l++
+			} else {
+				next = append(next, packages[p])
+				p++
+			}
+		}
+		list = next
+	}
+
+	lengths := make([]int, n)
+	var count func(item *mergeItem)
+	count = func(item *mergeItem) {
+		if item.leaf >= 0 {
+			lengths[item.leaf]++
+			return
+		}
+		count(item.left)
+		count(item.right)
+	}
+	for _, item := range list[:2*n-2] {
+		count(item)
+	}
+	return lengths
+}
+
+// Width returns the bit width of the code's symbols.
+func (h *Huffman) Width() int {
+	return h.width
+}
+
+// Code returns the code of the provided symbol and whether the symbol is present in the code.
+func (h *Huffman) Code(symbol int) (Phrase, bool) {
+	bits, ok := h.codes[symbol]
+	return NewPhraseFromBits(bits...), ok
+}
+
+// Encode replaces every symbol of the data with its code.
+//
+// NOTE: This returns an error if the data isn't a whole number of symbols, or if it holds a symbol without a code.
+func (h *Huffman) Encode(data Phrase) (Phrase, error) {
+	bits := data.Bits()
+	if len(bits)%h.width != 0 {
+		return nil, fmt.Errorf("%w: %d bits is not a whole number of %d-bit symbols", ErrorHuffmanSymbol, len(bits), h.width)
+	}
+
+	out := make([]Bit, 0, len(bits))
+	for i := 0; i < len(bits); i += h.width {
+		symbol := To.Number(h.width, bits[i:i+h.width]...)
+		code, ok := h.codes[symbol]
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", ErrorHuffmanSymbol, symbol)
+		}
+		out = append(out, code...)
+	}
+	return NewPhraseFromBits(out...), nil
+}
+
+// Decode replaces every code of the data with its symbol, reading until the data is exhausted.
+//
+// NOTE: This returns an error wrapping ErrorEndOfBits if the data ends partway through a code, or
+// ErrorHuffmanCode if the data holds a code which was never assigned.
+func (h *Huffman) Decode(data Phrase) (Phrase, error) {
+	c := newBitCursor(data)
+	out := make([]Bit, 0, len(c.bits))
+	for !c.exhausted() {
+		symbol, err := h.readSymbol(c)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, From.Number(symbol, h.width)...)
+	}
+	return NewPhraseFromBits(out...), nil
+}
+
+// readSymbol reads the next code from the cursor, using the lookup table whenever the code is short enough.
+func (h *Huffman) readSymbol(c *bitCursor) (int, error) {
+	start := c.i
+	entry := h.lookup[c.readNumber(h.lookupBits)]
+	if entry.length > 0 && start+entry.length <= len(c.bits) {
+		c.i = start + entry.length
+		return entry.symbol, nil
+	}
+
+	// Walk the canonical code one bit at a time
+	c.i = start
+	code, first, index := 0, 0, 0
+	for length := 1; length < len(h.counts); length++ {
+		if c.exhausted() {
+			return 0, fmt.Errorf("%w: truncated Huffman code at bit %d", ErrorEndOfBits, start)
+		}
+		code |= int(c.next())
+		if code-first < h.counts[length] {
+			return h.symbols[index+code-first], nil
+		}
+		index += h.counts[length]
+		first = (first + h.counts[length]) << 1
+		code <<= 1
+	}
+	return 0, fmt.Errorf("%w: at bit %d", ErrorHuffmanCode, start)
+}
+
+// Table serializes the code so it can be rebuilt with ReadHuffman.
+//
+// @formatter:off
+//
+// The number of codes of each length is Elias gamma coded as one more than the count, followed by every
+// symbol in canonical order -
+//
+//	| Width - 1 | Longest Length - 1 | Count of Length 1 + 1 | ... | Count of Longest + 1 | Symbols |
+//	|     6     |         6          |         γ             | ... |          γ           | Width × n |
+//
+// @formatter:on
+func (h *Huffman) Table() Phrase {
+	out := From.Number(h.width-1, 6)
+	out = append(out, From.Number(len(h.counts)-2, 6)...)
+	for _, count := range h.counts[1:] {
+		key, projection := gammaBits(big.NewInt(int64(count + 1)))
+		out = append(append(out, key...), projection...)
+	}
+	for _, symbol := range h.symbols {
+		out = append(out, From.Number(symbol, h.width)...)
+	}
+	return NewPhraseFromBits(out...)
+}
+
+// ReadHuffman rebuilds a Huffman code from the start of a serialized table, followed by the remainder.
+//
+// NOTE: This returns an error wrapping ErrorHuffmanTable if the table is truncated or describes an impossible code.
+func ReadHuffman(data Phrase) (h *Huffman, remainder Phrase, err error) {
+	c := newBitCursor(data)
+	truncated := func() (*Huffman, Phrase, error) {
+		return nil, data, fmt.Errorf("%w: truncated table", ErrorHuffmanTable)
+	}
+
+	if len(c.bits) < 12 {
+		return truncated()
+	}
+	width := c.readNumber(6) + 1
+	longest := c.readNumber(6) + 1
+	if width >= GetArchitectureBitWidth() || longest > MaxHuffmanLength {
+		return nil, data, fmt.Errorf("%w: unsupported width %d or length %d", ErrorHuffmanTable, width, longest)
+	}
+
+	counts := make([]int, longest+1)
+	total, kraft := 0, new(big.Int)
+	for length := 1; length <= longest; length++ {
+		if c.exhausted() {
+			return truncated()
+		}
+		count := c.readGamma()
+		if !count.IsInt64() || count.Int64()-1 > 1<<length {
+			return nil, data, fmt.Errorf("%w: invalid count of length %d", ErrorHuffmanTable, length)
+		}
+		counts[length] = int(count.Int64()) - 1
+		total += counts[length]
+		kraft.Add(kraft, new(big.Int).Lsh(big.NewInt(int64(counts[length])), uint(longest-length)))
+	}
+	if total == 0 || counts[longest] == 0 || kraft.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(longest))) > 0 {
+		return nil, data, fmt.Errorf("%w: the code lengths are impossible", ErrorHuffmanTable)
+	}
+
+	if len(c.bits)-c.i < total*width {
+		return truncated()
+	}
+	symbols := make([]int, total)
+	seen := make(map[int]struct{}, total)
+	for i := range symbols {
+		symbols[i] = c.readNumber(width)
+		if _, ok := seen[symbols[i]]; ok {
+			return nil, data, fmt.Errorf("%w: symbol %d is repeated", ErrorHuffmanTable, symbols[i])
+		}
+		seen[symbols[i]] = struct{}{}
+	}
+
+	return newCanonicalHuffman(width, symbols, counts), c.remainder(), nil
+}
@@ -0,0 +1,170 @@
+package tiny
+
+import (
+	"fmt"
+	"slices"
+)
+
+// LFSRMode selects how an LFSR feeds its taps back into its register.
+type LFSRMode int
+
+const (
+	// FibonacciLFSR XORs every tapped stage together and shifts the result into the first stage.
+	FibonacciLFSR LFSRMode = iota
+
+	// GaloisLFSR shifts the output bit back into the first stage and XORs it into every tapped stage.
+	GaloisLFSR
+)
+
+// LFSR is a linear feedback shift register, which emits a pseudo-random sequence of bits from its register.
+//
+// The taps are a phrase as long as the register, where a one at index i taps stage i+1 - representing the
+// term xⁱ⁺¹ of the feedback polynomial, with the +1 term implied.  The final stage must always be tapped.
+//
+// @formatter:off
+//
+// For example, PRBS7's polynomial x⁷ + x⁶ + 1 taps stages 6 and 7:
+//
+//	Stage | 1 2 3 4 5 6 7
+//	 Taps | 0 0 0 0 0 1 1
+//
+// @formatter:on
+//
+// The register emits its final stage on every step.  A primitive polynomial emits every non-zero state
+// of the register before repeating, giving a sequence of 2ⁿ - 1 bits.
+type LFSR struct {
+	taps  []Bit
+	state []Bit
+	mode  LFSRMode
+}
+
+// NewLFSR creates an LFSR of the provided taps, starting from the seed - in FibonacciLFSR mode, unless
+// another mode is provided.
+//
+// NOTE: This returns an error if the seed isn't as long as the taps, if the seed is all zeros, or if the
+// final stage isn't tapped.
+func NewLFSR(taps Phrase, seed Phrase, mode ...LFSRMode) (*LFSR, error) {
+	l := &LFSR{taps: taps.Bits(), state: seed.Bits()}
+	if len(mode) > 0 {
+		l.mode = mode[0]
+	}
+
+	if len(l.taps) == 0 || l.taps[len(l.taps)-1] != One {
+		return nil, fmt.Errorf("%w: the final stage must be tapped", ErrorLFSR)
+	}
+	if len(l.state) != len(l.taps) {
+		return nil, fmt.Errorf("%w: a seed of %d bits can't fill a %d stage register", ErrorLFSR, len(l.state), len(l.taps))
+	}
+	if !slices.Contains(l.state, One) {
+		return nil, fmt.Errorf("%w: an all zero seed never leaves zero", ErrorLFSR)
+	}
+	return l, nil
+}
+
+// prbsTaps holds the ITU-T O.150 feedback polynomial of each standard PRBS order, as its tapped stages.
+var prbsTaps = map[int][2]int{
+	7:  {6, 7},
+	9:  {5, 9},
+	15: {14, 15},
+	23: {18, 23},
+	31: {28, 31},
+}
+
+// NewPRBS creates a FibonacciLFSR of the standard PRBS polynomial of the provided order, seeded with all ones.
+//
+// @formatter:off
+//
+//	 Order | Polynomial
+//	 PRBS7 | x⁷  + x⁶  + 1
+//	 PRBS9 | x⁹  + x⁵  + 1
+//	PRBS15 | x¹⁵ + x¹⁴ + 1
+//	PRBS23 | x²³ + x¹⁸ + 1
+//	PRBS31 | x³¹ + x²⁸ + 1
+//
+// @formatter:on
+//
+// NOTE: This returns an error if the order isn't one of the above.
+func NewPRBS(order int) (*LFSR, error) {
+	stages, ok := prbsTaps[order]
+	if !ok {
+		return nil, fmt.Errorf("%w: no standard PRBS of order %d", ErrorLFSR, order)
+	}
+	taps := make([]Bit, order)
+	taps[stages[0]-1], taps[stages[1]-1] = One, One
+	return NewLFSR(NewPhraseFromBits(taps...), Synthesize.Ones(order))
+}
+
+// State returns the current contents of the register.
+func (l *LFSR) State() Phrase {
+	return NewPhraseFromBits(l.state...)
+}
+
+// Next steps the register once and returns the bit it emitted.
+func (l *LFSR) Next() Bit {
+	last := len(l.state) - 1
+	out := l.state[last]
+
+	switch l.mode {
+	case GaloisLFSR:
+		for i := last; i > 0; i-- {
+			l.state[i] = l.state[i-1] ^ (out & l.taps[i-1])
+		}
+		l.state[0] = out
+	default:
+		feedback := Zero
+		for i, tap := range l.taps {
+			feedback ^= tap & l.state[i]
+		}
+		copy(l.state[1:], l.state[:last])
+		l.state[0] = feedback
+	}
+	return out
+}
+
+// Read steps the register the provided number of times and returns every bit it emitted.
+func (l *LFSR) Read(length int) Phrase {
+	return Synthesize.ForEach(length, func(_ int) Bit { return l.Next() })
+}
+
+// PRBS synthesizes the first bits of the standard PRBS sequence of the provided order.  See NewPRBS.
+//
+// NOTE: This will panic if the order isn't a standard PRBS order.
+func (_ Synthesizer) PRBS(order int, length int) Phrase {
+	l, err := NewPRBS(order)
+	if err != nil {
+		panic(err)
+	}
+	return l.Read(length)
+}
+
+// DeBruijn synthesizes the binary de Bruijn sequence B(2, n) - the shortest cyclic sequence holding every
+// n-bit phrase exactly once.  The sequence is 2ⁿ bits long and is the lexicographically smallest, beginning
+// with n zeros.
+//
+// NOTE: This will panic if n is less than 1, or too large to index the sequence with an int.
+func (_ Synthesizer) DeBruijn(n int) Phrase {
+	if n < 1 || n >= GetArchitectureBitWidth()-1 {
+		panic("cannot synthesize a de Bruijn sequence of an order less than 1, or too wide to index")
+	}
+
+	// The Fredricksen-Kessler-Maiorana algorithm concatenates every Lyndon word whose length divides n
+	out := make([]Bit, 0, 1<<n)
+	a := make([]Bit, n+1)
+	var generate func(t int, p int)
+	generate = func(t int, p int) {
+		if t > n {
+			if n%p == 0 {
+				out = append(out, a[1:p+1]...)
+			}
+			return
+		}
+		a[t] = a[t-p]
+		generate(t+1, p)
+		if a[t-p] == Zero {
+			a[t] = One
+			generate(t+1, t)
+		}
+	}
+	generate(1, 1)
+	return NewPhraseFromBits(out...)
+}
@@ -0,0 +1,196 @@
+package tiny
+
+import (
+	"encoding/binary"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// MedleyVersion is the current version of the Medley binary serialization.
+const MedleyVersion = 1
+
+// Medley is a collection of named phrases, allowing the clustering of arbitrary measurements.
+//
+// The medley remembers the order in which each name was first set, and every walk across its
+// phrases follows that order.
+//
+// NOTE: The zero value is an empty medley ready to use.
+type Medley struct {
+	names   []string
+	phrases map[string]Phrase
+}
+
+// NewMedley creates an empty Medley.
+func NewMedley() *Medley {
+	return &Medley{
+		names:   make([]string, 0),
+		phrases: make(map[string]Phrase),
+	}
+}
+
+// Len returns the number of named phrases in the medley.
+func (m *Medley) Len() int {
+	return len(m.names)
+}
+
+// Names returns the names of every phrase in the medley, in insertion order.
+func (m *Medley) Names() []string {
+	return slices.Clone(m.names)
+}
+
+// Set names the provided phrase.  If the name already exists, its phrase is replaced in place.
+func (m *Medley) Set(name string, p Phrase) {
+	if m.phrases == nil {
+		m.phrases = make(map[string]Phrase)
+	}
+	if _, ok := m.phrases[name]; !ok {
+		m.names = append(m.names, name)
+	}
+	m.phrases[name] = p
+}
+
+// Get returns the named phrase and whether it exists.
+func (m *Medley) Get(name string) (Phrase, bool) {
+	p, ok := m.phrases[name]
+	return p, ok
+}
+
+// Delete removes the named phrase and returns whether it existed.
+func (m *Medley) Delete(name string) bool {
+	if _, ok := m.phrases[name]; !ok {
+		return false
+	}
+	delete(m.phrases, name)
+	m.names = slices.DeleteFunc(m.names, func(n string) bool { return n == name })
+	return true
+}
+
+// All walks every named phrase in insertion order.
+func (m *Medley) All() iter.Seq2[string, Phrase] {
+	return func(yield func(string, Phrase) bool) {
+		for _, name := range m.names {
+			if !yield(name, m.phrases[name]) {
+				return
+			}
+		}
+	}
+}
+
+// Merge creates a new Medley holding the phrases of both medleys.
+//
+// NOTE: If both medleys name the same phrase, the other medley's phrase wins while the source medley's order is kept.
+func (m *Medley) Merge(other *Medley) *Medley {
+	out := NewMedley()
+	for name, p := range m.All() {
+		out.Set(name, p)
+	}
+	for name, p := range other.All() {
+		out.Set(name, p)
+	}
+	return out
+}
+
+// Diff compares the source medley to the other medley and returns the names which were added, removed,
+// or changed in the other medley.
+//
+// NOTE: Phrases are compared bit-for-bit, ignoring how their bits are grouped into measurements.
+func (m *Medley) Diff(other *Medley) (added []string, removed []string, changed []string) {
+	for name, p := range m.All() {
+		o, ok := other.Get(name)
+		if !ok {
+			removed = append(removed, name)
+		} else if !slices.Equal(p.Bits(), o.Bits()) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range other.All() {
+		if _, ok := m.Get(name); !ok {
+			added = append(added, name)
+		}
+	}
+	return added, removed, changed
+}
+
+// MarshalBinary serializes the medley, recording the exact bit length of every phrase.
+//
+// @formatter:off
+//
+// Every length is written as an unsigned varint -
+//
+//	| Version | Count | Name Length | Name | Bit Length | Bits (padded to the byte with 0s) | ...
+//	|    1    |  ...  |     ...     |  ... |    ...     |                ...                | ...
+//
+// @formatter:on
+func (m *Medley) MarshalBinary() ([]byte, error) {
+	out := []byte{MedleyVersion}
+	out = binary.AppendUvarint(out, uint64(m.Len()))
+	for name, p := range m.All() {
+		bits := p.Bits()
+		out = binary.AppendUvarint(out, uint64(len(name)))
+		out = append(out, name...)
+		out = binary.AppendUvarint(out, uint64(len(bits)))
+		out = append(out, packBits(bits)...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary replaces the medley's contents with the serialized medley.
+//
+// NOTE: This returns an error wrapping ErrorMedleyFormat if the data is malformed or names a phrase twice.
+func (m *Medley) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: empty data", ErrorMedleyFormat)
+	}
+	if data[0] != MedleyVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrorMedleyFormat, data[0])
+	}
+	data = data[1:]
+
+	readLength := func() (int, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v > uint64(len(data))*8 {
+			return 0, fmt.Errorf("%w: invalid length", ErrorMedleyFormat)
+		}
+		data = data[n:]
+		return int(v), nil
+	}
+
+	count, err := readLength()
+	if err != nil {
+		return err
+	}
+
+	out := NewMedley()
+	for i := 0; i < count; i++ {
+		nameLength, err := readLength()
+		if err != nil {
+			return err
+		}
+		if len(data) < nameLength {
+			return fmt.Errorf("%w: truncated name", ErrorMedleyFormat)
+		}
+		name := string(data[:nameLength])
+		data = data[nameLength:]
+		if _, ok := out.Get(name); ok {
+			return fmt.Errorf("%w: duplicate name %q", ErrorMedleyFormat, name)
+		}
+
+		bitLength, err := readLength()
+		if err != nil {
+			return err
+		}
+		byteLength := (bitLength + 7) / 8
+		if len(data) < byteLength {
+			return fmt.Errorf("%w: truncated phrase %q", ErrorMedleyFormat, name)
+		}
+		out.Set(name, NewPhraseFromBits(unpackBits(data[:byteLength], bitLength)...))
+		data = data[byteLength:]
+	}
+	if len(data) > 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrorMedleyFormat, len(data))
+	}
+
+	*m = *out
+	return nil
+}
@@ -0,0 +1,50 @@
+package tiny
+
+import "context"
+
+// Movement holds the logical steps to perform a single cycle of binary transformation.
+//
+// A movement must be reversible - whatever Forward produces, Reverse must be able to turn back into
+// the original information.  Because leading zeros are not always preserved by a transformation, Reverse
+// is also handed the bit width the input held before the movement was performed.
+//
+// Forward is handed the context the composition is performed with - a long running movement should check
+// it as it works and return the context's error once it's cancelled.
+type Movement struct {
+	// Name identifies the movement when printing out a pathway.
+	Name string
+
+	// Forward performs a single cycle of transformation against the provided data.
+	Forward func(ctx context.Context, data Phrase) (Phrase, error)
+
+	// Reverse undoes a single cycle of transformation, given the input's original bit width.
+	Reverse func(data Phrase, width int) (Phrase, error)
+}
+
+// Step records a single movement that was performed while walking a recursive pathway.
+type Step struct {
+	// Movement is the index of the performed movement within the composition's movement set.
+	Movement int
+
+	// InputWidth is the bit width of the data before the movement was performed.
+	InputWidth int
+
+	// OutputWidth is the bit width of the data after the movement was performed.
+	OutputWidth int
+}
+
+// Pathway is the ordered set of steps taken while recursively performing movements.
+type Pathway []Step
+
+// Depth returns the number of steps taken along the pathway.
+func (p Pathway) Depth() int {
+	return len(p)
+}
+
+// Gain returns the total number of bits shed from the start of the pathway to the end.
+func (p Pathway) Gain() int {
+	if len(p) == 0 {
+		return 0
+	}
+	return p[0].InputWidth - p[len(p)-1].OutputWidth
+}
@@ -11,27 +11,74 @@ type Passage struct {
 	Delta        Phrase
 	DeltaWidth   int
 	InitialWidth int
+
+	// Scheme is the GrowthScheme the passage's widths were walked with.
+	//
+	// NOTE: If nil, every width from the initial width down to the delta width was walked.
+	Scheme GrowthScheme
+}
+
+// Pathway returns the widths the passage walks as a pathway, where each step records a level's width as
+// its InputWidth, the width its stride lands on as its OutputWidth, and the Movement its scheme grew.
+func (p Passage) Pathway() Pathway {
+	return growthSteps(p.InitialWidth, p.DeltaWidth, p.Scheme)
+}
+
+// DNA returns the passage as DNA - its signature as the start, the widths it walks as the pathway, and
+// its delta as the seed.  Unlike AsPhrase, this records everything needed to perform the passage again,
+// regardless of the scheme it was walked with.  See NewPassageFromDNA.
+func (p Passage) DNA() DNA {
+	return DNA{Start: p.Signature, Pathway: p.Pathway(), Seed: p.Delta}
+}
+
+// NewPassageFromDNA rebuilds a passage from DNA written by Passage.DNA.
+//
+// The rebuilt passage walks the recorded widths with a TableGrowth, so it performs identically to the
+// original - even if the original was walked with a custom scheme.
+//
+// NOTE: This returns an error wrapping ErrorDNAFormat if the pathway's widths don't descend.
+func NewPassageFromDNA(dna DNA) (Passage, error) {
+	p := Passage{Signature: dna.Start, Delta: dna.Seed}
+	if len(dna.Pathway) == 0 {
+		// A passage which walked no widths started narrower than its delta width
+		p.DeltaWidth = 1
+		return p, nil
+	}
+
+	table := make(TableGrowth, len(dna.Pathway))
+	for i, step := range dna.Pathway {
+		if step.InputWidth < 0 || step.OutputWidth < 0 || step.OutputWidth > step.InputWidth {
+			return Passage{}, fmt.Errorf("%w: passage step %d strides from %d to %d bits", ErrorDNAFormat, i, step.InputWidth, step.OutputWidth)
+		}
+		if i > 0 && step.InputWidth > dna.Pathway[i-1].OutputWidth {
+			return Passage{}, fmt.Errorf("%w: passage step %d widens to %d bits", ErrorDNAFormat, i, step.InputWidth)
+		}
+		table[i] = Growth{Width: step.InputWidth, Stride: step.InputWidth - step.OutputWidth, Movement: step.Movement}
+	}
+	p.InitialWidth = dna.Pathway[0].InputWidth
+	p.DeltaWidth = dna.Pathway[len(dna.Pathway)-1].InputWidth
+	p.Scheme = table
+	return p, nil
 }
 
 // Perform uses the current passage information to re-build the original information.
 func (p Passage) Perform() Phrase {
 	signature := p.Signature
 	delta := p.Delta.AsBigInt()
-	bitLength := p.InitialWidth
+	widths := growthWidths(p.InitialWidth, p.DeltaWidth, p.Scheme)
 
-	for i := p.DeltaWidth; i < bitLength+1; i++ {
+	for ii := len(widths) - 1; ii >= 0; ii-- {
+		i := widths[ii]
 		var sign Bit
 		sign, signature, _ = signature.ReadLastBit()
 
 		midpoint := Synthesize.Midpoint(i)
-		fmt.Println(midpoint)
 
 		if sign == One {
 			delta = new(big.Int).Sub(midpoint.AsBigInt(), delta)
 		} else {
 			delta = new(big.Int).Add(midpoint.AsBigInt(), delta)
 		}
-		fmt.Println(delta.Text(2))
 	}
 
 	return NewPhraseFromBigInt(delta)
@@ -40,6 +87,8 @@ func (p Passage) Perform() Phrase {
 // AsPhrase returns the passage as a Phrase aligned to the provided alignment.
 //
 // NOTE: If no alignment is provided, a standard of 8 bits is used.
+//
+// NOTE: This only holds the signature and delta - use DNA to record the widths needed to perform the passage again.
 func (p Passage) AsPhrase(alignment ...int) Phrase {
 	a := 8
 	if len(alignment) > 0 {
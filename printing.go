@@ -97,3 +97,20 @@ func (_ _print) DeltaCharacter(old int, new int) string {
 func (_ _print) BitDrop(point Phrase, index int) string {
 	return ""
 }
+
+// Generations draws each phrase on its own line, with ones as █ and zeros as spaces - such as the
+// generations of Synthesize.AutomatonHistory.
+func (_ _print) Generations(generations ...Phrase) string {
+	var builder strings.Builder
+	for _, generation := range generations {
+		for _, bit := range generation.Bits() {
+			if bit == One {
+				builder.WriteString("█")
+			} else {
+				builder.WriteString(" ")
+			}
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
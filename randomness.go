@@ -0,0 +1,378 @@
+package tiny
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// RandomnessSignificance is the significance level below which a randomness test's p-value fails.
+const RandomnessSignificance = 0.01
+
+// RandomnessResult is the outcome of a single statistical test of randomness.
+type RandomnessResult struct {
+	// Test names the test which was run.
+	Test string
+
+	// PValues holds every p-value the test produced - most tests produce one, while the serial and
+	// cumulative sums tests produce two.
+	PValues []float64
+
+	// Passed is true if every p-value is at least RandomnessSignificance.
+	Passed bool
+}
+
+// newRandomnessResult creates a result of the provided p-values and judges whether they passed.
+func newRandomnessResult(test string, pValues ...float64) RandomnessResult {
+	passed := true
+	for _, p := range pValues {
+		if p < RandomnessSignificance {
+			passed = false
+		}
+	}
+	return RandomnessResult{Test: test, PValues: pValues, Passed: passed}
+}
+
+// Randomness runs the battery of NIST SP 800-22 tests implemented by Analyze against the data, using
+// parameters suited to its length.
+//
+// @formatter:off
+//
+//	              Test | Parameters
+//	           Monobit |
+//	    BlockFrequency | The smallest block length of at least 20 bits yielding fewer than 100 blocks
+//	              Runs |
+//	        LongestRun |
+//	            Serial | m = max(2, ⌊log₂ n⌋ - 6)
+//	ApproximateEntropy | m = max(2, ⌊log₂ n⌋ - 7)
+//	    CumulativeSums |
+//
+// @formatter:on
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data holds fewer than 128 bits.
+func (a _analyze) Randomness(data Phrase) ([]RandomnessResult, error) {
+	n := data.BitLength()
+	if n < 128 {
+		return nil, fmt.Errorf("%w: the battery requires at least 128 bits, got %d", ErrorRandomness, n)
+	}
+	log := bits.Len(uint(n)) - 1
+
+	tests := []func() (RandomnessResult, error){
+		func() (RandomnessResult, error) { return a.Monobit(data) },
+		func() (RandomnessResult, error) { return a.BlockFrequency(data, max(20, n/99+1)) },
+		func() (RandomnessResult, error) { return a.Runs(data) },
+		func() (RandomnessResult, error) { return a.LongestRun(data) },
+		func() (RandomnessResult, error) { return a.Serial(data, max(2, log-6)) },
+		func() (RandomnessResult, error) { return a.ApproximateEntropy(data, max(2, log-7)) },
+		func() (RandomnessResult, error) { return a.CumulativeSums(data) },
+	}
+
+	results := make([]RandomnessResult, len(tests))
+	for i, test := range tests {
+		result, err := test()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// Monobit tests whether the proportion of ones in the data is close to one half.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data is empty.
+func (_ _analyze) Monobit(data Phrase) (RandomnessResult, error) {
+	n := data.BitLength()
+	if n == 0 {
+		return RandomnessResult{}, fmt.Errorf("%w: cannot test empty data", ErrorRandomness)
+	}
+
+	sum := 0
+	for _, b := range data.Bits() {
+		sum += 2*int(b) - 1
+	}
+	observed := math.Abs(float64(sum)) / math.Sqrt(float64(n))
+	return newRandomnessResult("Monobit", math.Erfc(observed/math.Sqrt2)), nil
+}
+
+// BlockFrequency tests whether the proportion of ones within each non-overlapping block of the
+// provided length is close to one half.  Any bits beyond the last whole block are ignored.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data can't fill a single block.
+func (_ _analyze) BlockFrequency(data Phrase, blockLength int) (RandomnessResult, error) {
+	if blockLength < 1 {
+		return RandomnessResult{}, fmt.Errorf("%w: cannot test blocks of %d bits", ErrorRandomness, blockLength)
+	}
+	b := data.Bits()
+	blocks := len(b) / blockLength
+	if blocks == 0 {
+		return RandomnessResult{}, fmt.Errorf("%w: %d bits can't fill a block of %d bits", ErrorRandomness, len(b), blockLength)
+	}
+
+	chi := 0.0
+	for i := 0; i < blocks; i++ {
+		ones := 0
+		for _, bit := range b[i*blockLength : (i+1)*blockLength] {
+			ones += int(bit)
+		}
+		deviation := float64(ones)/float64(blockLength) - 0.5
+		chi += deviation * deviation
+	}
+	chi *= 4 * float64(blockLength)
+	return newRandomnessResult("BlockFrequency", igamc(float64(blocks)/2, chi/2)), nil
+}
+
+// Runs tests whether the number of uninterrupted runs of identical bits is as expected of random data.
+//
+// NOTE: Data which fails the monobit prerequisite of the test is given a p-value of 0.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data is empty.
+func (_ _analyze) Runs(data Phrase) (RandomnessResult, error) {
+	b := data.Bits()
+	n := float64(len(b))
+	if len(b) == 0 {
+		return RandomnessResult{}, fmt.Errorf("%w: cannot test empty data", ErrorRandomness)
+	}
+
+	ones := 0
+	runs := 1
+	for i, bit := range b {
+		ones += int(bit)
+		if i > 0 && bit != b[i-1] {
+			runs++
+		}
+	}
+	pi := float64(ones) / n
+	if math.Abs(pi-0.5) >= 2/math.Sqrt(n) {
+		return newRandomnessResult("Runs", 0), nil
+	}
+
+	expected := 2 * n * pi * (1 - pi)
+	p := math.Erfc(math.Abs(float64(runs)-expected) / (2 * math.Sqrt(2*n) * pi * (1 - pi)))
+	return newRandomnessResult("Runs", p), nil
+}
+
+// longestRunClasses describes the block length, bounding run lengths, and class probabilities the
+// longest run test uses for data of at least the minimum length.
+var longestRunClasses = []struct {
+	minimum       int
+	blockLength   int
+	low, high     int
+	probabilities []float64
+}{
+	{750000, 10000, 10, 16, []float64{0.0882, 0.2092, 0.2483, 0.1933, 0.1208, 0.0675, 0.0727}},
+	{6272, 128, 4, 9, []float64{0.1174035788, 0.242955959, 0.249363483, 0.17517706, 0.102701071, 0.112398847}},
+	{128, 8, 1, 4, []float64{0.21484375, 0.3671875, 0.23046875, 0.1875}},
+}
+
+// LongestRun tests whether the longest run of ones within each block is as long as expected of random
+// data.  The block length is chosen from the length of the data.
+//
+// @formatter:off
+//
+//	   Length | Block Length
+//	   ≥ 128  | 8
+//	  ≥ 6272  | 128
+//	≥ 750000  | 10000
+//
+// @formatter:on
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data holds fewer than 128 bits.
+func (_ _analyze) LongestRun(data Phrase) (RandomnessResult, error) {
+	b := data.Bits()
+	for _, class := range longestRunClasses {
+		if len(b) < class.minimum {
+			continue
+		}
+
+		blocks := len(b) / class.blockLength
+		counts := make([]int, len(class.probabilities))
+		for i := 0; i < blocks; i++ {
+			longest, run := 0, 0
+			for _, bit := range b[i*class.blockLength : (i+1)*class.blockLength] {
+				if bit == One {
+					run++
+					longest = max(longest, run)
+				} else {
+					run = 0
+				}
+			}
+			counts[min(max(longest, class.low), class.high)-class.low]++
+		}
+
+		chi := 0.0
+		for i, probability := range class.probabilities {
+			expected := float64(blocks) * probability
+			chi += (float64(counts[i]) - expected) * (float64(counts[i]) - expected) / expected
+		}
+		return newRandomnessResult("LongestRun", igamc(float64(len(counts)-1)/2, chi/2)), nil
+	}
+	return RandomnessResult{}, fmt.Errorf("%w: the longest run test requires at least 128 bits, got %d", ErrorRandomness, len(b))
+}
+
+// overlappingCounts counts every overlapping m-bit pattern of the data, wrapping around its end.
+func overlappingCounts(b []Bit, m int) []int {
+	counts := make([]int, 1<<m)
+	for i := range b {
+		pattern := 0
+		for j := 0; j < m; j++ {
+			pattern = pattern<<1 | int(b[(i+j)%len(b)])
+		}
+		counts[pattern]++
+	}
+	return counts
+}
+
+// randomnessPatternLimit returns an error if m-bit patterns can't be meaningfully counted across the data.
+func randomnessPatternLimit(n int, m int, minimum int) error {
+	if m < minimum || m > bits.Len(uint(n)) || m > 24 {
+		return fmt.Errorf("%w: cannot test %d-bit patterns across %d bits", ErrorRandomness, m, n)
+	}
+	return nil
+}
+
+// Serial tests whether every overlapping m-bit pattern occurs about as often as every other, returning
+// the p-values of the first and second differences of the ψ² statistic.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if m is less than 2, or if 2ᵐ exceeds twice the data's length.
+func (_ _analyze) Serial(data Phrase, m int) (RandomnessResult, error) {
+	b := data.Bits()
+	n := len(b)
+	if err := randomnessPatternLimit(n, m, 2); err != nil {
+		return RandomnessResult{}, err
+	}
+
+	psi := func(m int) float64 {
+		if m <= 0 {
+			return 0
+		}
+		sum := 0.0
+		for _, count := range overlappingCounts(b, m) {
+			sum += float64(count) * float64(count)
+		}
+		return sum*float64(uint(1)<<m)/float64(n) - float64(n)
+	}
+
+	psiM, psiM1, psiM2 := psi(m), psi(m-1), psi(m-2)
+	first := igamc(math.Pow(2, float64(m-2)), (psiM-psiM1)/2)
+	second := igamc(math.Pow(2, float64(m-3)), (psiM-2*psiM1+psiM2)/2)
+	return newRandomnessResult("Serial", first, second), nil
+}
+
+// ApproximateEntropy tests whether overlapping m-bit and (m+1)-bit patterns occur with the frequencies
+// expected of random data.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if m is less than 1, or if 2ᵐ⁺¹ exceeds twice the data's length.
+func (_ _analyze) ApproximateEntropy(data Phrase, m int) (RandomnessResult, error) {
+	b := data.Bits()
+	n := len(b)
+	if err := randomnessPatternLimit(n, m+1, 2); err != nil {
+		return RandomnessResult{}, err
+	}
+
+	phi := func(m int) float64 {
+		sum := 0.0
+		for _, count := range overlappingCounts(b, m) {
+			if count > 0 {
+				pi := float64(count) / float64(n)
+				sum += pi * math.Log(pi)
+			}
+		}
+		return sum
+	}
+
+	apEn := phi(m) - phi(m+1)
+	chi := 2 * float64(n) * (math.Ln2 - apEn)
+	return newRandomnessResult("ApproximateEntropy", igamc(math.Pow(2, float64(m-1)), chi/2)), nil
+}
+
+// CumulativeSums tests whether the maximum excursion of the random walk formed by the data - where each
+// one steps up and each zero steps down - is as small as expected, returning the p-values of walking
+// forward and then in reverse.
+//
+// NOTE: This returns an error wrapping ErrorRandomness if the data is empty.
+func (_ _analyze) CumulativeSums(data Phrase) (RandomnessResult, error) {
+	b := data.Bits()
+	n := len(b)
+	if n == 0 {
+		return RandomnessResult{}, fmt.Errorf("%w: cannot test empty data", ErrorRandomness)
+	}
+
+	excursion := func(reverse bool) int {
+		sum, z := 0, 0
+		for i := range b {
+			bit := b[i]
+			if reverse {
+				bit = b[n-1-i]
+			}
+			sum += 2*int(bit) - 1
+			z = max(z, sum, -sum)
+		}
+		return z
+	}
+
+	normal := func(x float64) float64 {
+		return math.Erfc(-x/math.Sqrt2) / 2
+	}
+	pValue := func(z int) float64 {
+		fn, fz, root := float64(n), float64(z), math.Sqrt(float64(n))
+		sum1, sum2 := 0.0, 0.0
+		for k := int((-fn/fz + 1) / 4); k <= int((fn/fz-1)/4); k++ {
+			sum1 += normal(float64(4*k+1)*fz/root) - normal(float64(4*k-1)*fz/root)
+		}
+		for k := int((-fn/fz - 3) / 4); k <= int((fn/fz-1)/4); k++ {
+			sum2 += normal(float64(4*k+3)*fz/root) - normal(float64(4*k+1)*fz/root)
+		}
+		return 1 - sum1 + sum2
+	}
+
+	return newRandomnessResult("CumulativeSums", pValue(excursion(false)), pValue(excursion(true))), nil
+}
+
+// igamc calculates the regularized upper incomplete gamma function Q(a, x).
+func igamc(a float64, x float64) float64 {
+	if x <= 0 || a <= 0 {
+		return 1
+	}
+
+	const epsilon = 1e-15
+	lgamma, _ := math.Lgamma(a)
+	scale := math.Exp(-x + a*math.Log(x) - lgamma)
+
+	if x < a+1 {
+		// The series of the lower incomplete gamma function converges quickly here
+		term := 1 / a
+		sum := term
+		for n := a + 1; math.Abs(term) > math.Abs(sum)*epsilon; n++ {
+			term *= x / n
+			sum += term
+		}
+		return max(0, 1-sum*scale)
+	}
+
+	// Otherwise, Lentz's method evaluates the continued fraction of the upper incomplete gamma function
+	const smallest = 1e-300
+	b := x + 1 - a
+	c := 1 / smallest
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < smallest {
+			d = smallest
+		}
+		c = b + an/c
+		if math.Abs(c) < smallest {
+			c = smallest
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return scale * h
+}
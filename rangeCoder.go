@@ -0,0 +1,234 @@
+package tiny
+
+import "fmt"
+
+/**
+Range Coding
+*/
+
+// bitModelPrecision is the number of bits a BitModel's probability is measured in.
+const bitModelPrecision = 11
+
+// bitModelAdaptation is how quickly a BitModel moves towards the bits it observes - a larger shift adapts slower.
+const bitModelAdaptation = 5
+
+// rangeTop is the point below which the coder's range is renormalized by shifting out another byte.
+const rangeTop = 1 << 24
+
+// BitModel is an adaptive probability that the next bit is a zero, measured in 1/2048ths.
+//
+// NOTE: A model never adapts to a probability of 0, so the zero value is read as the midpoint - it considers
+// a zero and a one equally likely, just as NewBitModel does.
+type BitModel uint16
+
+// NewBitModel creates a BitModel which considers a zero and a one equally likely.
+func NewBitModel() BitModel {
+	return 1 << (bitModelPrecision - 1)
+}
+
+// probability returns the model's probability that the next bit is a zero, reading the zero value as the midpoint.
+func (m *BitModel) probability() uint32 {
+	if *m == 0 {
+		return uint32(NewBitModel())
+	}
+	return uint32(*m)
+}
+
+// update moves the model's probability towards the observed bit.
+func (m *BitModel) update(bit Bit) {
+	if *m == 0 {
+		*m = NewBitModel()
+	}
+	if bit == Zero {
+		*m += (1<<bitModelPrecision - *m) >> bitModelAdaptation
+	} else {
+		*m -= *m >> bitModelAdaptation
+	}
+}
+
+// BitModels holds a BitModel for every context a coder has seen.
+type BitModels map[int]*BitModel
+
+// Model returns the BitModel of the provided context, creating it if this is the first time it's been seen.
+func (m BitModels) Model(context int) *BitModel {
+	model, ok := m[context]
+	if !ok {
+		model = new(BitModel)
+		*model = NewBitModel()
+		m[context] = model
+	}
+	return model
+}
+
+// ContextFunc chooses the context of the next bit from every bit which came before it.
+type ContextFunc func(preceding []Bit) int
+
+// OrderContext creates a ContextFunc which uses the previous k bits as the context.
+//
+// The bits are led with a one so the first k bits of data, which have fewer bits before them, get their own contexts.
+//
+// NOTE: This will panic if k is negative or too wide for your architecture.
+func OrderContext(k int) ContextFunc {
+	if k < 0 || k >= GetArchitectureBitWidth()-1 {
+		panic("cannot create an order context of a negative or overly wide order")
+	}
+	return func(preceding []Bit) int {
+		context := 1
+		for _, bit := range preceding[max(0, len(preceding)-k):] {
+			context = context<<1 | int(bit)
+		}
+		return context
+	}
+}
+
+// RangeEncoder is a binary range coder, in the style of LZMA, which codes each bit against a BitModel.
+type RangeEncoder struct {
+	low       uint64
+	span      uint32
+	cache     byte
+	cacheSize int
+	out       []byte
+}
+
+// NewRangeEncoder creates a RangeEncoder ready to encode bits.
+func NewRangeEncoder() *RangeEncoder {
+	return &RangeEncoder{span: 0xFFFFFFFF, cacheSize: 1}
+}
+
+// Encode codes the bit against the model, and then adapts the model to the bit.
+func (e *RangeEncoder) Encode(bit Bit, model *BitModel) {
+	bound := (e.span >> bitModelPrecision) * model.probability()
+	if bit == Zero {
+		e.span = bound
+	} else {
+		e.low += uint64(bound)
+		e.span -= bound
+	}
+	model.update(bit)
+
+	for e.span < rangeTop {
+		e.span <<= 8
+		e.shiftLow()
+	}
+}
+
+// shiftLow emits the top byte of low, holding back any run of 0xFF bytes a later carry could still ripple into.
+func (e *RangeEncoder) shiftLow() {
+	if uint32(e.low) < 0xFF000000 || e.low>>32 != 0 {
+		carry := byte(e.low >> 32)
+		held := e.cache
+		for ; e.cacheSize > 0; e.cacheSize-- {
+			e.out = append(e.out, held+carry)
+			held = 0xFF
+		}
+		e.cache = byte(e.low >> 24)
+	}
+	e.cacheSize++
+	e.low = (e.low & 0x00FFFFFF) << 8
+}
+
+// Finish flushes the encoder and returns every coded bit.
+//
+// NOTE: The first byte an LZMA style coder emits is always zero, and the decoder reads zeros past the
+// end of its data, so both the leading byte and any trailing zero bytes are left out.
+func (e *RangeEncoder) Finish() Phrase {
+	for i := 0; i < 5; i++ {
+		e.shiftLow()
+	}
+	out := e.out[1:]
+	for len(out) > 0 && out[len(out)-1] == 0 {
+		out = out[:len(out)-1]
+	}
+	return NewPhrase(out...)
+}
+
+// RangeDecoder reads bits coded by a RangeEncoder, given the same models in the same order.
+type RangeDecoder struct {
+	code uint32
+	span uint32
+	data []byte
+}
+
+// NewRangeDecoder creates a RangeDecoder over the output of RangeEncoder.Finish.
+//
+// NOTE: This returns an error if the data isn't a whole number of bytes.
+func NewRangeDecoder(data Phrase) (*RangeDecoder, error) {
+	if data.BitLength()%8 != 0 {
+		return nil, fmt.Errorf("%w: %d bits is not a whole number of bytes", ErrorRangeCode, data.BitLength())
+	}
+	d := &RangeDecoder{span: 0xFFFFFFFF, data: To.Measure(data.Bits()...).Bytes}
+	for i := 0; i < 4; i++ {
+		d.code = d.code<<8 | uint32(d.nextByte())
+	}
+	return d, nil
+}
+
+// nextByte reads the next byte of data, or a zero if every byte has been read.
+func (d *RangeDecoder) nextByte() byte {
+	if len(d.data) == 0 {
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+// Decode reads the next bit against the model, and then adapts the model to the bit.
+func (d *RangeDecoder) Decode(model *BitModel) Bit {
+	bound := (d.span >> bitModelPrecision) * model.probability()
+	bit := Zero
+	if d.code < bound {
+		d.span = bound
+	} else {
+		d.code -= bound
+		d.span -= bound
+		bit = One
+	}
+	model.update(bit)
+
+	for d.span < rangeTop {
+		d.span <<= 8
+		d.code = d.code<<8 | uint32(d.nextByte())
+	}
+	return bit
+}
+
+// RangeEncode codes every bit of the data against an adaptive model of its context.
+//
+// If no ContextFunc is provided, every bit shares a single model.  See OrderContext for modeling each bit
+// from the bits which came before it.
+func RangeEncode(data Phrase, context ContextFunc) Phrase {
+	if context == nil {
+		context = OrderContext(0)
+	}
+	bits := data.Bits()
+	models := make(BitModels)
+	e := NewRangeEncoder()
+	for i, bit := range bits {
+		e.Encode(bit, models.Model(context(bits[:i])))
+	}
+	return e.Finish()
+}
+
+// RangeDecode reads the provided number of bits coded by RangeEncode using the same ContextFunc.
+//
+// NOTE: The coded data doesn't record its own length, so it must be provided.
+func RangeDecode(data Phrase, length int, context ContextFunc) (Phrase, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("%w: cannot decode a negative length", ErrorRangeCode)
+	}
+	if context == nil {
+		context = OrderContext(0)
+	}
+	d, err := NewRangeDecoder(data)
+	if err != nil {
+		return nil, err
+	}
+
+	bits := make([]Bit, 0, length)
+	models := make(BitModels)
+	for i := 0; i < length; i++ {
+		bits = append(bits, d.Decode(models.Model(context(bits))))
+	}
+	return NewPhraseFromBits(bits...), nil
+}
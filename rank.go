@@ -0,0 +1,61 @@
+package tiny
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Rank locates the phrase among every phrase of the same width and weight, using the combinatorial number
+// system.  The weight is the number of ones in the phrase, and the rank is its index among all such phrases
+// when walked in ascending numeric order - the same order as Synthesize.AllWithWeight.
+//
+// Each one contributes the binomial coefficient of its position, counted from the least significant bit,
+// over how many ones have been seen so far -
+//
+// @formatter:off
+//
+//	Position  4 3 2 1 0
+//	          1 0 1 1 0   rank = C(1,1) + C(2,2) + C(4,3) = 1 + 1 + 4 = 6
+//
+// @formatter:on
+//
+// See Synthesize.Unrank to reverse the process - it accepts the weight and rank exactly as they're returned.
+func (_ _analyze) Rank(data Phrase) (weight *big.Int, rank *big.Int) {
+	bits := data.Bits()
+	rank = new(big.Int)
+	binomial := new(big.Int)
+	ones := int64(0)
+	for i := len(bits) - 1; i >= 0; i-- {
+		if bits[i] == One {
+			ones++
+			rank.Add(rank, binomial.Binomial(int64(len(bits)-1-i), ones))
+		}
+	}
+	return big.NewInt(ones), rank
+}
+
+// Unrank synthesizes the phrase of the provided width and weight found at the rank.  See Analyze.Rank.
+//
+// NOTE: This will panic if the weight is negative or greater than the width, or if the rank is negative
+// or not less than the number of phrases of that weight.
+func (_ Synthesizer) Unrank(width int, weight *big.Int, rank *big.Int) Phrase {
+	if weight.Sign() < 0 || weight.Cmp(big.NewInt(int64(width))) > 0 {
+		panic("cannot unrank a negative weight, or more ones than bits")
+	}
+	ones := weight.Int64()
+	if count := new(big.Int).Binomial(int64(width), ones); rank.Sign() < 0 || rank.Cmp(count) >= 0 {
+		panic(fmt.Sprintf("rank %v is outside of the %v phrases of width %d and weight %d", rank, count, width, ones))
+	}
+
+	bits := make([]Bit, width)
+	remaining := new(big.Int).Set(rank)
+	binomial := new(big.Int)
+	for position := width - 1; position >= 0 && ones > 0; position-- {
+		if binomial.Binomial(int64(position), ones).Cmp(remaining) <= 0 {
+			bits[width-1-position] = One
+			remaining.Sub(remaining, binomial)
+			ones--
+		}
+	}
+	return NewPhraseFromBits(bits...)
+}
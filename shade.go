@@ -0,0 +1,143 @@
+package tiny
+
+import (
+	"runtime"
+	"sync"
+)
+
+// shadeConcurrencyThreshold is the number of bits below which Analyze.PositionalShade works alone by default.
+const shadeConcurrencyThreshold = 1 << 16
+
+// PositionalShade is a BinaryShade of any width, counting the ones found in each position of every
+// symbol of that width rather than of every byte.
+type PositionalShade struct {
+	// Width is the bit width of the symbols the distribution describes.
+	Width int
+	// Zeros are a count of the number of 0s in the target.
+	Zeros int
+	// Ones are a count of the number of 1s in the target.
+	Ones int
+	// Total is a tally of all 1s and 0s.
+	Total int
+	// Shade details the general nature of the 1s and 0s, or is Undefined if there were none.
+	Shade Shade
+	// PredominantlyDark is true if more than half of the binary data is a 1.
+	PredominantlyDark bool
+	// Distribution is a count of how many ones are in each position of every symbol of the target, from
+	// the most significant position.
+	Distribution []int
+}
+
+// Merge combines the counts of two shades of the same width, as though their data had been shaded together.
+//
+// A zero value shade adopts the width of the shade it's merged with, so it can begin an accumulation.
+//
+// NOTE: This will panic if both shades have a width and they differ.
+func (s PositionalShade) Merge(other PositionalShade) PositionalShade {
+	if s.Width == 0 {
+		s.Width = other.Width
+	}
+	if other.Width != 0 && other.Width != s.Width {
+		panic("cannot merge shades of different widths")
+	}
+
+	distribution := make([]int, s.Width)
+	for i := range distribution {
+		if i < len(s.Distribution) {
+			distribution[i] += s.Distribution[i]
+		}
+		if i < len(other.Distribution) {
+			distribution[i] += other.Distribution[i]
+		}
+	}
+
+	s.Zeros += other.Zeros
+	s.Ones += other.Ones
+	s.Total += other.Total
+	s.Distribution = distribution
+	s.calculate()
+	return s
+}
+
+func (s *PositionalShade) calculate() {
+	b := BinaryShade{Zeros: s.Zeros, Ones: s.Ones, Total: s.Total}
+	b.calculate()
+	s.Shade, s.PredominantlyDark = b.Shade, b.PredominantlyDark
+	if s.Total == 0 {
+		// There's nothing to measure
+		s.Shade = Undefined
+	}
+}
+
+// positionalShade shades the bits, the first of which begins a symbol.
+func positionalShade(bits []Bit, width int) PositionalShade {
+	s := PositionalShade{Width: width, Distribution: make([]int, width)}
+	position := 0
+	for _, bit := range bits {
+		if bit == One {
+			s.Distribution[position]++
+			s.Ones++
+		} else {
+			s.Zeros++
+		}
+		s.Total++
+		position++
+		if position == width {
+			position = 0
+		}
+	}
+	s.calculate()
+	return s
+}
+
+// PositionalShade gives heuristics around the distribution of 1s in the provided phrase, as though it
+// were read as a sequence of symbols of the provided width - regardless of its measurements' alignment.
+// A width of 8 gives the same distribution as BitShade.  An empty phrase has an Undefined shade.
+//
+// Large phrases are split across goroutines, one per available CPU, whose shades are merged together.  If
+// you'd prefer a specific number of workers, you may provide it.
+//
+// NOTE: This will panic if the width or the number of workers is less than 1.
+func (_ _analyze) PositionalShade(data Phrase, width int, workers ...int) PositionalShade {
+	if width < 1 {
+		panic("cannot shade symbols narrower than 1 bit")
+	}
+	bits := data.Bits()
+
+	w := 1
+	if len(workers) > 0 {
+		w = workers[0]
+		if w < 1 {
+			panic("cannot shade with fewer than one worker")
+		}
+	} else if len(bits) >= shadeConcurrencyThreshold {
+		w = runtime.NumCPU()
+	}
+
+	// Each worker receives a whole number of symbols, so every chunk begins at position 0
+	chunk := (len(bits) + w - 1) / w
+	chunk = max(width, (chunk+width-1)/width*width)
+
+	shades := make([]PositionalShade, 0, w)
+	for start := 0; start < len(bits); start += chunk {
+		shades = append(shades, PositionalShade{})
+	}
+
+	var wg sync.WaitGroup
+	for i := range shades {
+		start := i * chunk
+		end := min(start+chunk, len(bits))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shades[i] = positionalShade(bits[start:end], width)
+		}()
+	}
+	wg.Wait()
+
+	out := positionalShade(nil, width)
+	for _, s := range shades {
+		out = out.Merge(s)
+	}
+	return out
+}
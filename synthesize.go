@@ -2,10 +2,54 @@ package tiny
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"math/big"
+	mathrand "math/rand/v2"
 )
 
-type _synthesize struct{}
+// Synthesizer creates binary slices from known parameters.
+//
+// The package level Synthesize draws its randomness from crypto/rand, while a Synthesizer created with
+// NewSynthesizer draws it from a seeded PCG source - allowing any random synthesis to be replayed.
+//
+// NOTE: A seeded Synthesizer, and every copy of it, shares a single source which is not safe for concurrent use.
+type Synthesizer struct {
+	seed   uint64
+	random *mathrand.Rand
+}
+
+// NewSynthesizer creates a Synthesizer whose randomness is entirely determined by the provided seed.
+//
+// If no seed is provided, one is drawn from crypto/rand - call Seed to log it for a later replay.
+func NewSynthesizer(seed ...uint64) Synthesizer {
+	var s uint64
+	if len(seed) > 0 {
+		s = seed[0]
+	} else {
+		var b [8]byte
+		_, _ = rand.Read(b[:])
+		s = binary.BigEndian.Uint64(b[:])
+	}
+	return Synthesizer{seed: s, random: mathrand.New(mathrand.NewPCG(s, synthesizerStream))}
+}
+
+// synthesizerStream selects the PCG stream every seeded Synthesizer draws from.
+const synthesizerStream = 0x9E3779B97F4A7C15
+
+// Seed returns the seed the synthesizer was created with, or 0 if it draws from crypto/rand.
+func (s Synthesizer) Seed() uint64 {
+	return s.seed
+}
+
+// randomBit draws a single uniformly random bit from the synthesizer's source.
+func (s Synthesizer) randomBit() Bit {
+	if s.random == nil {
+		var b [1]byte
+		_, _ = rand.Read(b[:])
+		return Bit(b[0] % 2)
+	}
+	return Bit(s.random.Uint64() & 1)
+}
 
 // ForEach calls the provided function the desired number of times and then builds
 // a Phrase from the collected results of all invocations.
@@ -24,7 +68,7 @@ type _synthesize struct{}
 //	 }
 //	 return Zero
 //	})
-func (_ _synthesize) ForEach(count int, f func(int) Bit) Phrase {
+func (_ Synthesizer) ForEach(count int, f func(int) Bit) Phrase {
 	var bytes []byte
 	var bits []Bit
 	subI := 0
@@ -53,18 +97,23 @@ func (_ _synthesize) ForEach(count int, f func(int) Bit) Phrase {
 // You can reconstruct the original information by "performing" the passage.
 //
 // This may or may not get an overall reduction in bits - however, on average, you will gain 2 bits =)
-func (s _synthesize) Passage(target Phrase, deltaWidth int) Passage {
+//
+// If you'd like to walk the widths using a different set of rules, you may optionally provide a GrowthScheme.
+func (s Synthesizer) Passage(target Phrase, deltaWidth int, scheme ...GrowthScheme) Passage {
 	p := Passage{
 		Signature:    NewPhrase(),
 		Delta:        NewPhrase(),
 		DeltaWidth:   deltaWidth,
 		InitialWidth: target.BitLength(),
 	}
+	if len(scheme) > 0 {
+		p.Scheme = scheme[0]
+	}
 
 	delta := target.AsBigInt()
 
-	for i := p.InitialWidth; i >= deltaWidth; i-- {
-		midpoint := Synthesize.Midpoint(i)
+	for _, i := range growthWidths(p.InitialWidth, deltaWidth, p.Scheme) {
+		midpoint := s.Midpoint(i)
 
 		delta = new(big.Int).Sub(delta, midpoint.AsBigInt())
 		if delta.Sign() < 0 {
@@ -80,12 +129,12 @@ func (s _synthesize) Passage(target Phrase, deltaWidth int) Passage {
 }
 
 // Ones creates a slice of '1's of the requested length.
-func (s _synthesize) Ones(count int) Phrase {
+func (s Synthesizer) Ones(count int) Phrase {
 	return s.ForEach(count, func(i int) Bit { return One })
 }
 
 // Zeros creates a slice of '0's of the requested length.
-func (s _synthesize) Zeros(count int) Phrase {
+func (s Synthesizer) Zeros(count int) Phrase {
 	return s.ForEach(count, func(i int) Bit { return Zero })
 }
 
@@ -111,7 +160,7 @@ func (s _synthesize) Zeros(count int) Phrase {
 // This allows us to decay a dark value exponentially using a light value.
 //
 // @formatter:on
-func (s _synthesize) TrailingZeros(count int, zeros int) Phrase {
+func (s Synthesizer) TrailingZeros(count int, zeros int) Phrase {
 	remainder := count
 	return s.ForEach(count, func(i int) Bit {
 		remainder--
@@ -123,7 +172,7 @@ func (s _synthesize) TrailingZeros(count int, zeros int) Phrase {
 }
 
 // Midpoint creates a slice with a '1' in the first position and zeros in all subsequent positions.
-func (s _synthesize) Midpoint(width int) Phrase {
+func (s Synthesizer) Midpoint(width int) Phrase {
 	return s.ForEach(width, func(i int) Bit {
 		if i == 0 {
 			return One
@@ -135,7 +184,7 @@ func (s _synthesize) Midpoint(width int) Phrase {
 // Point synthesizes the target point in the provided index.  This is restricted to a "practical limit"
 // of 64 bits, as the input type is unable to address anything larger - if you wish to directly synthesize
 // a wider point, please consider an alternative means.
-func (s _synthesize) Point(i int, index int) Phrase {
+func (s Synthesizer) Point(i int, index int) Phrase {
 	return NewPhraseFromBits(From.Number(i, index)...)
 }
 
@@ -144,7 +193,7 @@ func (s _synthesize) Point(i int, index int) Phrase {
 // Use Repeating when you want the entire pattern emitted a fixed number of times.
 //
 // Use Pattern when you want the pattern to fit within a specified length.
-func (s _synthesize) Repeating(count int, pattern ...Bit) Phrase {
+func (s Synthesizer) Repeating(count int, pattern ...Bit) Phrase {
 	patternI := 0
 	return s.ForEach(count*len(pattern), func(_ int) Bit {
 		bit := pattern[patternI]
@@ -159,7 +208,7 @@ func (s _synthesize) Repeating(count int, pattern ...Bit) Phrase {
 // Pattern repeats the provided pattern up to the desired length.
 // Use Pattern when you want the pattern to fit within a specified length.
 // Use Repeating when you want the entire pattern emitted a fixed number of times.
-func (s _synthesize) Pattern(length int, pattern ...Bit) Phrase {
+func (s Synthesizer) Pattern(length int, pattern ...Bit) Phrase {
 	patternI := 0
 	return s.ForEach(length, func(_ int) Bit {
 		bit := pattern[patternI]
@@ -179,11 +228,9 @@ func (s _synthesize) Pattern(length int, pattern ...Bit) Phrase {
 // NOTE: This ensures it will never return all 1s, 0s, or repeating [ 1 0 ] [ 0 1 ].
 //
 // NOTE: This will panic if given a length greater than your architecture's bit width.
-func (s _synthesize) RandomBits(bitLength int, generator ...func(int) Bit) Phrase {
+func (s Synthesizer) RandomBits(bitLength int, generator ...func(int) Bit) Phrase {
 	g := func(_ int) Bit {
-		var b [1]byte
-		_, _ = rand.Read(b[:])
-		return Bit(b[0] % 2)
+		return s.randomBit()
 	}
 	if len(generator) > 0 && generator[0] != nil {
 		g = generator[0]
@@ -219,7 +266,7 @@ func (s _synthesize) RandomBits(bitLength int, generator ...func(int) Bit) Phras
 // If you would prefer different width measurements, you may provide your own width.
 //
 // NOTE: This will panic if you provide a measurement width of 0 or less, or greater than your architecture's bit width.
-func (s _synthesize) RandomPhrase(measurementCount int, measurementWidth ...int) (phrase Phrase) {
+func (s Synthesizer) RandomPhrase(measurementCount int, measurementWidth ...int) (phrase Phrase) {
 	return s.RandomPhraseCustom(measurementCount, nil, measurementWidth...)
 }
 
@@ -230,7 +277,7 @@ func (s _synthesize) RandomPhrase(measurementCount int, measurementWidth ...int)
 // If you would prefer different width measurements, you may provide your own width.
 //
 // NOTE: This will panic if you provide a measurement width of 0 or less, or greater than your architecture's bit width.
-func (s _synthesize) RandomPhraseCustom(measurementCount int, generator func(int) Bit, measurementWidth ...int) (phrase Phrase) {
+func (s Synthesizer) RandomPhraseCustom(measurementCount int, generator func(int) Bit, measurementWidth ...int) (phrase Phrase) {
 	if measurementCount == 0 {
 		return phrase
 	}
@@ -273,7 +320,7 @@ func (s _synthesize) RandomPhraseCustom(measurementCount int, generator func(int
 //		              ⬑ The repetend
 //
 // @formatter:on
-func (s _synthesize) Boundary(msbs []Bit, repetend Bit, width int) Phrase {
+func (s Synthesizer) Boundary(msbs []Bit, repetend Bit, width int) Phrase {
 	if width == 0 {
 		return Phrase{}
 	}
@@ -299,7 +346,7 @@ func (s _synthesize) Boundary(msbs []Bit, repetend Bit, width int) Phrase {
 // NOTE: This will panic if provided a negative depth or width.
 //
 // NOTE: If you'd like only light boundaries, please pass false to includeDark.
-func (s _synthesize) AllBoundaries(depth int, width int, includeDark ...bool) (boundaries []Phrase) {
+func (s Synthesizer) AllBoundaries(depth int, width int, includeDark ...bool) (boundaries []Phrase) {
 	include := true
 	if len(includeDark) > 0 {
 		include = includeDark[0]
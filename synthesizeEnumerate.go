@@ -0,0 +1,123 @@
+package tiny
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Order describes the sequence in which every phrase of a width is enumerated.
+type Order int
+
+const (
+	// CountingOrder enumerates phrases in ascending numeric order.
+	CountingOrder Order = iota
+
+	// GrayOrder enumerates phrases in reflected binary Gray code order, where each phrase differs from
+	// the last by a single bit.
+	GrayOrder
+)
+
+// enumerationLimit panics if every phrase of the provided width can't be indexed by an int.
+func enumerationLimit(width int) {
+	if width < 0 || width >= GetArchitectureBitWidth()-1 {
+		panic("cannot enumerate a negative width, or one too wide to index with an int")
+	}
+}
+
+// phraseAt returns the phrase at the provided index of an enumeration.
+func phraseAt(width int, index int, order Order) Phrase {
+	if order == GrayOrder {
+		index ^= index >> 1
+	}
+	return NewPhraseFromBits(From.Number(index, width)...)
+}
+
+// All walks every phrase of the provided width - in CountingOrder, unless another order is provided.
+//
+// NOTE: This will panic if the width is negative, or too wide to index every phrase with an int.
+func (s Synthesizer) All(width int, order ...Order) iter.Seq[Phrase] {
+	return func(yield func(Phrase) bool) {
+		for _, p := range s.AllRange(width, 0, 1<<width, order...) {
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// AllRange walks the phrases of the provided width from the start index up to, but not including, the end
+// index - yielding each phrase's index alongside it.
+//
+// Record the index of the last phrase you handled and you can resume from the next one later.
+//
+// NOTE: This will panic if the width is negative, or too wide to index every phrase with an int.
+func (s Synthesizer) AllRange(width int, start int, end int, order ...Order) iter.Seq2[int, Phrase] {
+	enumerationLimit(width)
+	o := CountingOrder
+	if len(order) > 0 {
+		o = order[0]
+	}
+	start, end = max(start, 0), min(end, 1<<width)
+
+	return func(yield func(int, Phrase) bool) {
+		for i := start; i < end; i++ {
+			if !yield(i, phraseAt(width, i, o)) {
+				return
+			}
+		}
+	}
+}
+
+// Partition splits every phrase of the provided width into the requested number of contiguous shards,
+// which can be walked independently - for instance, by separate workers.
+//
+// Shards differ in size by no more than one phrase.  If there are more shards than phrases, the excess
+// shards are empty.
+//
+// NOTE: This will panic if fewer than one shard is requested, or under the same conditions as AllRange.
+func (s Synthesizer) Partition(width int, shards int, order ...Order) []iter.Seq2[int, Phrase] {
+	enumerationLimit(width)
+	if shards < 1 {
+		panic("cannot partition phrases into fewer than one shard")
+	}
+
+	total := 1 << width
+	out := make([]iter.Seq2[int, Phrase], shards)
+	for i := range out {
+		start := total/shards*i + min(i, total%shards)
+		end := start + total/shards
+		if i < total%shards {
+			end++
+		}
+		out[i] = s.AllRange(width, start, end, order...)
+	}
+	return out
+}
+
+// AllWithWeight walks every phrase of the provided width holding exactly k ones, in ascending numeric order.
+//
+// NOTE: This will panic if k is negative or greater than the width, or under the same conditions as All.
+func (s Synthesizer) AllWithWeight(width int, k int) iter.Seq[Phrase] {
+	enumerationLimit(width)
+	if k < 0 || k > width {
+		panic("cannot enumerate phrases with a negative weight, or more ones than bits")
+	}
+
+	return func(yield func(Phrase) bool) {
+		if k == 0 {
+			yield(NewPhraseFromBits(From.Number(0, width)...))
+			return
+		}
+
+		// Gosper's hack - the next larger value with the same number of ones
+		limit := uint(1) << width
+		for v := uint(1)<<k - 1; v < limit; {
+			if !yield(NewPhraseFromBits(From.Number(int(v), width)...)) {
+				return
+			}
+			lowest := v & -v
+			ripple := v + lowest
+			v = ripple | (v^ripple)>>(bits.TrailingZeros(lowest)+2)
+		}
+	}
+}
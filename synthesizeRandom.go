@@ -0,0 +1,128 @@
+package tiny
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+)
+
+// nextUint64 draws 64 uniformly random bits from the synthesizer's source.
+func (s Synthesizer) nextUint64() uint64 {
+	if s.random == nil {
+		var b [8]byte
+		_, _ = rand.Read(b[:])
+		return binary.BigEndian.Uint64(b[:])
+	}
+	return s.random.Uint64()
+}
+
+// nextFloat64 draws a uniformly random value in [0.0, 1.0) from the synthesizer's source.
+func (s Synthesizer) nextFloat64() float64 {
+	return float64(s.nextUint64()>>11) / (1 << 53)
+}
+
+// intN draws a uniformly random value in [0, n) from the synthesizer's source.
+func (s Synthesizer) intN(n int) int {
+	limit := math.MaxUint64 - math.MaxUint64%uint64(n)
+	for {
+		if v := s.nextUint64(); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+// Uniform creates a phrase of the desired bit length where every bit is independently and uniformly random.
+//
+// Unlike RandomBits, this places no limit on the length and never rejects a result - all ones, all zeros
+// and alternating phrases are as likely as any other.
+func (s Synthesizer) Uniform(length int) Phrase {
+	var word uint64
+	return s.ForEach(length, func(i int) Bit {
+		if i%64 == 0 {
+			word = s.nextUint64()
+		}
+		bit := Bit(word >> 63)
+		word <<= 1
+		return bit
+	})
+}
+
+// Biased creates a phrase of the desired bit length where every bit is independently a one with the
+// provided probability.
+//
+// NOTE: This will panic if the probability is outside of [0.0, 1.0].
+func (s Synthesizer) Biased(length int, p float64) Phrase {
+	if !(p >= 0 && p <= 1) {
+		panic("cannot synthesize bits with a probability outside of [0, 1]")
+	}
+	return s.ForEach(length, func(_ int) Bit {
+		if s.nextFloat64() < p {
+			return One
+		}
+		return Zero
+	})
+}
+
+// WithShade creates a phrase of the desired bit length holding exactly the provided number of ones, with
+// every arrangement of them equally likely.
+//
+// NOTE: This will panic if the number of ones is negative or greater than the length.
+func (s Synthesizer) WithShade(length int, ones int) Phrase {
+	if ones < 0 || ones > length {
+		panic("cannot synthesize a phrase with more ones than bits, or a negative number of ones")
+	}
+
+	// Selection sampling - each position takes a one with the odds of the ones left over the positions left
+	remaining := ones
+	return s.ForEach(length, func(i int) Bit {
+		if remaining > 0 && s.intN(length-i) < remaining {
+			remaining--
+			return One
+		}
+		return Zero
+	})
+}
+
+// Markov creates a phrase of the desired bit length from a Markov chain of the provided order.
+//
+// Each row of the transition matrix is selected by the previous order bits, read as a number, and weighs
+// the odds of the next bit being a zero or a one.  The first order bits are uniformly random.
+//
+// @formatter:off
+//
+// For example, a first order chain which favors repeating its previous bit:
+//
+//	Synthesize.Markov(1024, [][2]float64{
+//		{ 0.9, 0.1 }, // After a 0
+//		{ 0.1, 0.9 }, // After a 1
+//	}, 1)
+//
+// @formatter:on
+//
+// NOTE: This will panic if the matrix doesn't have 2ᵒʳᵈᵉʳ rows, or if any row holds a negative weight or no weight at all.
+func (s Synthesizer) Markov(length int, transitions [][2]float64, order int) Phrase {
+	if order < 0 || order >= GetArchitectureBitWidth()-1 || len(transitions) != 1<<order {
+		panic("a Markov chain's transition matrix must have 2ᵒʳᵈᵉʳ rows")
+	}
+	for _, row := range transitions {
+		if !(row[0] >= 0 && row[1] >= 0) || row[0]+row[1] <= 0 {
+			panic("a Markov chain's transitions must be non-negative and not all zero")
+		}
+	}
+
+	mask := 1<<order - 1
+	state := 0
+	return s.ForEach(length, func(i int) Bit {
+		bit := Zero
+		if i < order {
+			bit = s.randomBit()
+		} else {
+			row := transitions[state]
+			if s.nextFloat64()*(row[0]+row[1]) >= row[0] {
+				bit = One
+			}
+		}
+		state = (state<<1 | int(bit)) & mask
+		return bit
+	})
+}
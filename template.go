@@ -0,0 +1,233 @@
+package tiny
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTemplateCount is the largest repetition or run length a template may request.
+const maxTemplateCount = 1 << 24
+
+// maxTemplateDepth is the deepest a template may nest its groups.
+const maxTemplateDepth = 256
+
+// TemplateError describes why a template couldn't be parsed, and where.
+type TemplateError struct {
+	// Column is the 1-based position, in runes, of the offending character within the template.
+	Column int
+
+	// Message describes what went wrong.
+	Message string
+}
+
+func (e TemplateError) Error() string {
+	return fmt.Sprintf("%s at column %d: %s", ErrorMsgTemplate, e.Column, e.Message)
+}
+
+// Unwrap allows errors.Is to match every TemplateError against ErrorTemplate.
+func (e TemplateError) Unwrap() error {
+	return ErrorTemplate
+}
+
+// FromTemplate synthesizes a phrase from a compact template.  Whitespace is ignored, and each atom of
+// the template may be followed by a repetition count in braces.
+//
+// @formatter:off
+//
+//	      Atom | Meaning
+//	    0 or 1 | A literal bit
+//	  ( ...  ) | A group of atoms
+//	         r | A random bit - or r{𝑛} for a run of 𝑛 random bits
+//	n:𝑣/𝑤     | The number 𝑣 in 𝑤 bits - 𝑣 may be decimal, 0x hex, 0o octal, or 0b binary
+//
+// For example:
+//
+//	Synthesize.FromTemplate("1{3} 0{5} (10){4} r{12} n:0x1F/5")
+//
+// @formatter:on
+//
+// NOTE: A repetition repeats its atom's bits - so a repeated random run repeats the same random bits.
+//
+// NOTE: This returns a TemplateError, holding the column at fault, if the template can't be parsed or nests
+// its groups more than 256 deep.
+func (s Synthesizer) FromTemplate(template string) (Phrase, error) {
+	p := &templateParser{synthesizer: s, source: template}
+	out, err := p.sequence(-1)
+	if err != nil {
+		return nil, err
+	}
+	return NewPhraseFromBits(out...), nil
+}
+
+// templateParser walks a template one character at a time.
+type templateParser struct {
+	synthesizer Synthesizer
+	source      string
+	i           int
+	depth       int
+}
+
+// fail creates a TemplateError at the provided byte index.
+func (p *templateParser) fail(index int, format string, a ...any) error {
+	column := utf8.RuneCountInString(p.source[:index]) + 1
+	return TemplateError{Column: column, Message: fmt.Sprintf(format, a...)}
+}
+
+// skipSpace advances past any whitespace, reporting if any characters remain.
+func (p *templateParser) skipSpace() bool {
+	for p.i < len(p.source) && strings.ContainsRune(" \t\r\n", rune(p.source[p.i])) {
+		p.i++
+	}
+	return p.i < len(p.source)
+}
+
+// sequence parses atoms until the end of the template - or, if opened is not negative, until the
+// closing parenthesis of the group opened at that index.
+func (p *templateParser) sequence(opened int) ([]Bit, error) {
+	out := make([]Bit, 0)
+	for p.skipSpace() {
+		if p.source[p.i] == ')' {
+			if opened < 0 {
+				return nil, p.fail(p.i, "unexpected ')'")
+			}
+			p.i++
+			return out, nil
+		}
+
+		atom, err := p.atom()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, atom...)
+		if len(out) > maxTemplateCount {
+			return nil, p.fail(p.i-1, "the phrase exceeds %d bits", maxTemplateCount)
+		}
+	}
+
+	if opened >= 0 {
+		return nil, p.fail(opened, "unclosed '('")
+	}
+	return out, nil
+}
+
+// atom parses a single atom, followed by its optional repetition.
+func (p *templateParser) atom() ([]Bit, error) {
+	start := p.i
+	var out []Bit
+
+	switch c := p.source[p.i]; c {
+	case '0', '1':
+		out = []Bit{Bit(c - '0')}
+		p.i++
+	case '(':
+		if p.depth == maxTemplateDepth {
+			return nil, p.fail(start, "groups may not nest more than %d deep", maxTemplateDepth)
+		}
+		p.i++
+		p.depth++
+		group, err := p.sequence(start)
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		out = group
+	case 'r':
+		p.i++
+		length := 1
+		if p.i < len(p.source) && p.source[p.i] == '{' {
+			var err error
+			if length, err = p.count(); err != nil {
+				return nil, err
+			}
+		}
+		out = p.synthesizer.Uniform(length).Bits()
+	case 'n':
+		number, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		out = number
+	default:
+		r, _ := utf8.DecodeRuneInString(p.source[start:])
+		return nil, p.fail(start, "unexpected %q", r)
+	}
+
+	if p.i < len(p.source) && p.source[p.i] == '{' {
+		at := p.i
+		count, err := p.count()
+		if err != nil {
+			return nil, err
+		}
+		if count*len(out) > maxTemplateCount {
+			return nil, p.fail(at, "the repetition exceeds %d bits", maxTemplateCount)
+		}
+		repeated := make([]Bit, 0, count*len(out))
+		for i := 0; i < count; i++ {
+			repeated = append(repeated, out...)
+		}
+		out = repeated
+	}
+	return out, nil
+}
+
+// count parses a count in braces.
+func (p *templateParser) count() (int, error) {
+	open := p.i
+	p.i++
+	start := p.i
+	for p.i < len(p.source) && p.source[p.i] >= '0' && p.source[p.i] <= '9' {
+		p.i++
+	}
+	if p.i == start {
+		return 0, p.fail(p.i, "expected a count after '{'")
+	}
+	if p.i >= len(p.source) || p.source[p.i] != '}' {
+		return 0, p.fail(open, "unclosed '{'")
+	}
+
+	count, err := strconv.Atoi(p.source[start:p.i])
+	if err != nil || count > maxTemplateCount {
+		return 0, p.fail(start, "a count may not exceed %d", maxTemplateCount)
+	}
+	p.i++
+	return count, nil
+}
+
+// number parses a numeric literal of the form n:value/width.
+func (p *templateParser) number() ([]Bit, error) {
+	p.i++
+	if p.i >= len(p.source) || p.source[p.i] != ':' {
+		return nil, p.fail(p.i, "expected ':' after 'n'")
+	}
+	p.i++
+
+	start := p.i
+	for p.i < len(p.source) && p.source[p.i] != '/' && !strings.ContainsRune(" \t\r\n{()", rune(p.source[p.i])) {
+		p.i++
+	}
+	value, err := strconv.ParseUint(p.source[start:p.i], 0, 64)
+	if err != nil {
+		return nil, p.fail(start, "invalid number %q", p.source[start:p.i])
+	}
+	if p.i >= len(p.source) || p.source[p.i] != '/' {
+		return nil, p.fail(p.i, "expected '/' and a width after the number")
+	}
+	p.i++
+
+	widthStart := p.i
+	for p.i < len(p.source) && p.source[p.i] >= '0' && p.source[p.i] <= '9' {
+		p.i++
+	}
+	width, err := strconv.Atoi(p.source[widthStart:p.i])
+	if err != nil || width < 1 || width > 64 {
+		return nil, p.fail(widthStart, "expected a width from 1 to 64")
+	}
+	if bits.Len64(value) > width {
+		return nil, p.fail(start, "%d doesn't fit in %d bits", value, width)
+	}
+	return From.BigInt(new(big.Int).SetUint64(value), width), nil
+}
@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"strings"
+	"testing"
+)
+
+func Test_Synthesize_Automaton_Rule30(t *testing.T) {
+	history := tiny.Synthesize.AutomatonHistory(30, tiny.NewPhraseFromString("0001000"), 3, tiny.ZeroBoundary)
+	expected := []string{"0001000", "0011100", "0110010", "1101111"}
+	CompareValues(len(history), len(expected), t)
+	for i, generation := range history {
+		CompareValues(generation.StringBinary(), expected[i], t)
+	}
+}
+
+func Test_Synthesize_Automaton_Rule90(t *testing.T) {
+	// Rule 90 sets each cell to the XOR of its neighbors
+	seed := tiny.NewSynthesizer(31).Uniform(64)
+	for _, boundary := range []tiny.AutomatonBoundary{tiny.WrapBoundary, tiny.ZeroBoundary, tiny.OneBoundary} {
+		before := seed.Bits()
+		after := tiny.Synthesize.Automaton(90, seed, 1, boundary).Bits()
+		edge := tiny.Bit(0)
+		if boundary == tiny.OneBoundary {
+			edge = 1
+		}
+		for i := range after {
+			left, right := edge, edge
+			if i > 0 {
+				left = before[i-1]
+			} else if boundary == tiny.WrapBoundary {
+				left = before[len(before)-1]
+			}
+			if i < len(before)-1 {
+				right = before[i+1]
+			} else if boundary == tiny.WrapBoundary {
+				right = before[0]
+			}
+			CompareValues(after[i], left^right, t)
+		}
+	}
+}
+
+func Test_Synthesize_Automaton_Wrap(t *testing.T) {
+	// Rule 170 shifts every cell left, so wrapping cycles the seed back around
+	seed := tiny.NewPhraseFromString("1100101")
+	CompareValues(tiny.Synthesize.Automaton(170, seed, 7, tiny.WrapBoundary).StringBinary(), "1100101", t)
+	CompareValues(tiny.Synthesize.Automaton(170, seed, 7, tiny.ZeroBoundary).StringBinary(), "0000000", t)
+	CompareValues(tiny.Synthesize.Automaton(170, seed, 0, tiny.ZeroBoundary).StringBinary(), "1100101", t)
+}
+
+func Test_Synthesize_Automaton_MatchesHistory(t *testing.T) {
+	seed := tiny.NewSynthesizer(17).Uniform(33)
+	history := tiny.Synthesize.AutomatonHistory(110, seed, 50, tiny.WrapBoundary)
+	for generations := range history {
+		CompareValues(tiny.Synthesize.Automaton(110, seed, generations, tiny.WrapBoundary).StringBinary(), history[generations].StringBinary(), t)
+	}
+}
+
+func Test_Synthesize_Automaton_ConstantMemory(t *testing.T) {
+	// Only the current and next generations are held, no matter how many generations are evolved
+	seed := tiny.NewSynthesizer(17).Uniform(64)
+	short := testing.AllocsPerRun(5, func() { tiny.Synthesize.Automaton(30, seed, 10, tiny.WrapBoundary) })
+	long := testing.AllocsPerRun(5, func() { tiny.Synthesize.Automaton(30, seed, 10000, tiny.WrapBoundary) })
+	CompareValues(long, short, t)
+}
+
+func Test_Synthesize_AutomatonGenerator(t *testing.T) {
+	// The center column of Rule 30 (OEIS A051023)
+	expected := "1101110011000101100100111"
+	seed := tiny.Synthesize.Midpoint(101).Bits()
+	seed = append(make([]tiny.Bit, 50), seed[:51]...)
+	generator := tiny.Synthesize.AutomatonGenerator(30, tiny.NewPhraseFromBits(seed...), tiny.ZeroBoundary)
+
+	column := tiny.Synthesize.ForEach(len(expected), generator)
+	CompareValues(column.StringBinary(), expected, t)
+
+	phrase := tiny.Synthesize.RandomPhraseCustom(4, tiny.Synthesize.AutomatonGenerator(30, tiny.NewPhraseFromBits(seed...), tiny.ZeroBoundary), 12)
+	CompareValues(phrase.BitLength(), 48, t)
+}
+
+func Test_Print_Generations(t *testing.T) {
+	out := tiny.Print.Generations(tiny.Synthesize.AutomatonHistory(90, tiny.NewPhraseFromString("00100"), 1, tiny.ZeroBoundary)...)
+	CompareValues(out, "  █  \n █ █ \n", t)
+	CompareValues(strings.Count(out, "\n"), 2, t)
+}
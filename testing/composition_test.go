@@ -0,0 +1,139 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+	"time"
+)
+
+var trimLeading = tiny.Movement{
+	Name: "trim leading zeros",
+	Forward: func(_ context.Context, data tiny.Phrase) (tiny.Phrase, error) {
+		return data.ToNumericForm().Align(), nil
+	},
+	Reverse: func(data tiny.Phrase, width int) (tiny.Phrase, error) {
+		return data.PadLeftToLength(width).Align(), nil
+	},
+}
+
+var trimTrailing = tiny.Movement{
+	Name: "trim trailing zeros",
+	Forward: func(_ context.Context, data tiny.Phrase) (tiny.Phrase, error) {
+		bits := data.Bits()
+		end := len(bits)
+		for end > 0 && bits[end-1] == 0 {
+			end--
+		}
+		return tiny.NewPhraseFromBits(bits[:end]...), nil
+	},
+	Reverse: func(data tiny.Phrase, width int) (tiny.Phrase, error) {
+		return data.PadRightToLength(width).Align(), nil
+	},
+}
+
+func Test_Composition_Perform(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+
+	result, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(result.StringBinary(), "1011", t)
+	CompareValues(pathway.Depth(), 2, t)
+	CompareValues(pathway[0].Movement, 0, t)
+	CompareValues(pathway[1].Movement, 1, t)
+	CompareValues(pathway.Gain(), 8, t)
+}
+
+func Test_Composition_Perform_Concurrent(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+	c.Concurrent = true
+
+	result, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(result.StringBinary(), "1011", t)
+	CompareValues(pathway.Depth(), 2, t)
+}
+
+func Test_Composition_Perform_MaxDepth(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+	c.MaxDepth = 1
+
+	result, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(result.StringBinary(), "1011000", t)
+	CompareValues(pathway.Depth(), 1, t)
+}
+
+func Test_Composition_Perform_TargetWidth(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+	c.TargetWidth = 8
+
+	_, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(pathway.Depth(), 1, t)
+}
+
+func Test_Composition_Perform_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := tiny.NewComposition(tiny.NewPhraseFromString("000001011000"), trimLeading, trimTrailing)
+	_, pathway, err := c.Perform(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	CompareValues(pathway.Depth(), 0, t)
+}
+
+func Test_Composition_Perform_CancelledWithinMovement(t *testing.T) {
+	// A movement which would never finish on its own stops once the context is cancelled
+	endless := tiny.Movement{
+		Name: "endless",
+		Forward: func(ctx context.Context, data tiny.Phrase) (tiny.Phrase, error) {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				default:
+				}
+			}
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	c := tiny.NewComposition(tiny.NewPhraseFromString("000001011000"), trimLeading, endless)
+	_, pathway, err := c.Perform(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	CompareValues(pathway.Depth(), 0, t)
+}
+
+func Test_Composition_Replay(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+
+	result, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovered, err := c.Replay(result, pathway)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareUnalignedPhrases(recovered, seed, t)
+}
@@ -0,0 +1,116 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"runtime"
+	"testing"
+)
+
+func testDNA() tiny.DNA {
+	return tiny.DNA{
+		Start: tiny.NewPhraseFromString("1011"),
+		Pathway: tiny.Pathway{
+			{Movement: 0, InputWidth: 12, OutputWidth: 7},
+			{Movement: 1, InputWidth: 7, OutputWidth: 4},
+		},
+		Seed: tiny.NewPhraseFromString("10110011101"),
+	}
+}
+
+func Test_DNA_RoundTrip(t *testing.T) {
+	dna := testDNA()
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, dna); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := tiny.ReadDNA(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(out.Start.Bits(), dna.Start.Bits(), t)
+	CompareSlices(out.Pathway, dna.Pathway, t)
+	CompareSlices(out.Seed.Bits(), dna.Seed.Bits(), t)
+}
+
+func Test_DNA_Empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, tiny.DNA{}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := tiny.ReadDNA(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(out.Start.BitLength(), 0, t)
+	CompareValues(out.Pathway.Depth(), 0, t)
+	CompareValues(out.Seed.BitLength(), 0, t)
+}
+
+func Test_DNA_Checksum(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, testDNA()); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	data[len(data)-5] ^= 1
+
+	_, err := tiny.ReadDNA(bytes.NewReader(data))
+	if !errors.Is(err, tiny.ErrorDNAChecksum) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorDNAChecksum, err)
+	}
+}
+
+func Test_DNA_Version(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, testDNA()); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	data[4] = tiny.DNAVersion + 1
+
+	_, err := tiny.ReadDNA(bytes.NewReader(data))
+	if !errors.Is(err, tiny.ErrorDNAVersion) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorDNAVersion, err)
+	}
+}
+
+func Test_DNA_Truncated(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, testDNA()); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	_, err := tiny.ReadDNA(bytes.NewReader(data[:len(data)-10]))
+	if !errors.Is(err, tiny.ErrorDNAFormat) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorDNAFormat, err)
+	}
+}
+
+func Test_DNA_CorruptLength(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := tiny.WriteDNA(buf, testDNA()); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	// The start region's length follows the header, the name's length, and the name
+	offset := 5 + 1 + len(tiny.MovementStart)
+	copy(data[offset:], []byte{0x7F, 0xFF, 0xFF, 0xFF})
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := tiny.ReadDNA(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+
+	if !errors.Is(err, tiny.ErrorDNAFormat) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorDNAFormat, err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Errorf("Expected a corrupt length to allocate little, allocated %d bytes", allocated)
+	}
+}
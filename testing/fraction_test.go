@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func Test_Synthesize_Fraction(t *testing.T) {
+	floor, ceil, nearest := tiny.Synthesize.Fraction(1, 3, 8)
+	CompareValues(floor.StringBinary(), "01010101", t)
+	CompareValues(ceil.StringBinary(), "01010110", t)
+	CompareValues(nearest.StringBinary(), "01010101", t)
+
+	floor, ceil, nearest = tiny.Synthesize.Fraction(2, 3, 8)
+	CompareValues(tiny.To.Number(8, floor.Bits()...), 170, t)
+	CompareValues(tiny.To.Number(8, ceil.Bits()...), 171, t)
+	CompareValues(tiny.To.Number(8, nearest.Bits()...), 171, t)
+
+	floor, ceil, nearest = tiny.Synthesize.Fraction(2, 5, 4)
+	CompareValues(floor.StringBinary(), "0110", t)
+	CompareValues(ceil.StringBinary(), "0111", t)
+	CompareValues(nearest.StringBinary(), "0110", t)
+}
+
+func Test_Synthesize_Fraction_MatchesBoundaries(t *testing.T) {
+	boundaries := tiny.Synthesize.AllBoundaries(3, 8)
+	for i := 0; i <= 8; i++ {
+		floor, ceil, nearest := tiny.Synthesize.Fraction(i, 8, 8)
+		ComparePhrases(floor, boundaries[i], t)
+		ComparePhrases(ceil, boundaries[i], t)
+		ComparePhrases(nearest, boundaries[i], t)
+	}
+}
+
+func Test_Synthesize_Fraction_ShouldPanicAboveOne(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.Fraction(4, 3, 8)
+}
+
+func Test_Analyze_Locate(t *testing.T) {
+	l := tiny.Analyze.Locate(tiny.NewPhraseFromString("10110011"), 3)
+	CompareValues(l.Interval, 5, t)
+	CompareValues(l.Lower.StringBinary(), "10100000", t)
+	CompareValues(l.Upper.StringBinary(), "11000000", t)
+	CompareValues(l.FromLower.Int64(), 19, t)
+	CompareValues(l.ToUpper.Int64(), 13, t)
+
+	l = tiny.Analyze.Locate(tiny.NewPhraseFromString("11111111"), 3)
+	CompareValues(l.Interval, 7, t)
+	CompareValues(l.Upper.StringBinary(), "11111111", t)
+	CompareValues(l.ToUpper.Int64(), 0, t)
+}
+
+func Test_Analyze_LocateFraction(t *testing.T) {
+	// A byte split into thirds has boundaries at 0, 85, 170 and the dark boundary
+	for v := 0; v < 256; v++ {
+		l := tiny.Analyze.LocateFraction(tiny.NewPhraseFromBits(tiny.From.Number(v, 8)...), 3)
+		lower := tiny.To.Number(8, l.Lower.Bits()...)
+		upper := tiny.To.Number(8, l.Upper.Bits()...)
+
+		expected := 0
+		if v >= 170 {
+			expected = 2
+		} else if v >= 85 {
+			expected = 1
+		}
+		CompareValues(l.Interval, expected, t)
+		CompareValues(int(l.FromLower.Int64()), v-lower, t)
+		CompareValues(int(l.ToUpper.Int64()), upper-v, t)
+	}
+}
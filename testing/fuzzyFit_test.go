@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"math/rand/v2"
+	"testing"
+)
+
+func Test_Analyze_FuzzyCost(t *testing.T) {
+	values := []int{0, 1, 3, 17, 3, 3}
+	cost := tiny.Analyze.FuzzyCost(values, tiny.FuzzyCandidate{Name: "Five", Codec: tiny.Fuzzy.Five})
+	CompareValues(cost.Representable(), true, t)
+	CompareValues(cost.Bits, tiny.Fuzzy.EncodeAll(tiny.Fuzzy.Five, values).BitLength(), t)
+
+	cost = tiny.Analyze.FuzzyCost(values, tiny.FuzzyCandidate{Name: "Gamma", Codec: tiny.Fuzzy.Gamma})
+	CompareSlices(cost.Unrepresentable, []int{0}, t)
+	CompareValues(cost.Bits, tiny.Fuzzy.EncodeAll(tiny.Fuzzy.Gamma, values[1:]).BitLength(), t)
+}
+
+func Test_Analyze_BestFuzzy(t *testing.T) {
+	values := []int{0, 1, 0, 2, 1, 0, 60, 0, 1, 3}
+	costs := tiny.Analyze.BestFuzzy(values)
+	CompareValues(len(costs), len(tiny.FuzzyCandidates()), t)
+
+	for i := 1; i < len(costs); i++ {
+		a, b := costs[i-1], costs[i]
+		if a.Representable() && b.Representable() && a.Bits > b.Bits {
+			t.Errorf("%s (%d bits) was ranked ahead of %s (%d bits)", a.Name, a.Bits, b.Name, b.Bits)
+		}
+		if !a.Representable() && b.Representable() {
+			t.Errorf("%s can't represent every value but was ranked ahead of %s", a.Name, b.Name)
+		}
+	}
+
+	// Five can't hold 60, so can only be ranked behind every map that can
+	for _, c := range costs {
+		if c.Name == "Five" {
+			CompareSlices(c.Unrepresentable, []int{60}, t)
+		}
+	}
+	CompareValues(costs[0].Representable(), true, t)
+}
+
+func Test_FuzzyCandidates_RoundTrip(t *testing.T) {
+	for _, c := range tiny.FuzzyCandidates() {
+		cost := tiny.Analyze.FuzzyCost([]int{1, 2, 3, 7, 30, 31}, c)
+		if !cost.Representable() {
+			t.Errorf("%s couldn't represent %v", c.Name, cost.Unrepresentable)
+		}
+	}
+}
+
+func Test_Analyze_FitFuzzy(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	values := make([]int, 2000)
+	for i := range values {
+		switch {
+		case i%50 == 0:
+			values[i] = r.IntN(5000)
+		case i%5 == 0:
+			values[i] = r.IntN(40)
+		default:
+			values[i] = r.IntN(3)
+		}
+	}
+
+	scheme, err := tiny.Analyze.FitFuzzy(values, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fitted := tiny.Analyze.FuzzyCost(values, tiny.FuzzyCandidate{Name: "Fitted", Codec: scheme})
+	CompareValues(fitted.Representable(), true, t)
+
+	for _, c := range tiny.Analyze.BestFuzzy(values) {
+		if c.Representable() && c.Bits < fitted.Bits {
+			t.Errorf("Expected the fitted scheme (%d bits) to beat %s (%d bits)", fitted.Bits, c.Name, c.Bits)
+		}
+	}
+
+	decoded, err := tiny.Fuzzy.DecodeAll(scheme, tiny.Fuzzy.EncodeAll(scheme, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded, values, t)
+}
+
+func Test_Analyze_FitFuzzy_Offset(t *testing.T) {
+	values := []int{-10, -10, -9, -10, -3}
+	scheme, err := tiny.Analyze.FitFuzzy(values, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high := scheme.Range()
+	CompareValues(low, -10, t)
+	if high < -3 {
+		t.Errorf("Expected the scheme to reach -3, got %d", high)
+	}
+	decoded, err := tiny.Fuzzy.DecodeAll(scheme, tiny.Fuzzy.EncodeAll(scheme, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded, values, t)
+
+	single, err := tiny.Analyze.FitFuzzy([]int{42, 42, 42}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(tiny.Fuzzy.EncodeAll(single, []int{42, 42, 42}).BitLength(), 0, t)
+}
+
+func Test_Analyze_FitFuzzy_Clustered(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i % 2
+		if i%4 >= 2 {
+			values[i] += 1000
+		}
+	}
+
+	// A row for each cluster spends a one bit key and a one bit projection on every value
+	handBuilt, _ := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Width: 1, Offset: 0},
+			{Width: 1, Offset: 1000},
+		},
+	})
+	expected := tiny.Analyze.FuzzyCost(values, tiny.FuzzyCandidate{Name: "Hand Built", Codec: handBuilt})
+	CompareValues(expected.Bits, 2000, t)
+
+	scheme, err := tiny.Analyze.FitFuzzy(values, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fitted := tiny.Analyze.FuzzyCost(values, tiny.FuzzyCandidate{Name: "Fitted", Codec: scheme})
+	CompareValues(fitted.Representable(), true, t)
+	CompareValues(fitted.Bits, expected.Bits, t)
+}
+
+func Test_Analyze_FitFuzzy_ShortestKeysToBusiestRows(t *testing.T) {
+	// Most values are large, so the row covering them should hold the one bit key
+	values := []int{0, 1, 2, 3}
+	for i := 0; i < 100; i++ {
+		values = append(values, 500)
+	}
+	scheme, err := tiny.Analyze.FitFuzzy(values, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, projection := scheme.Encode(500)
+	CompareValues(key.StringBinary(), "1", t)
+	CompareValues(projection.BitLength(), 0, t)
+	CompareValues(tiny.Fuzzy.EncodeAll(scheme, values).BitLength(), 100+4*3, t)
+}
+
+func Test_Analyze_FitFuzzy_Errors(t *testing.T) {
+	_, err := tiny.Analyze.FitFuzzy(nil, 3)
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+	_, err = tiny.Analyze.FitFuzzy([]int{1}, 0)
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func Test_Fuzzy_Stream(t *testing.T) {
+	values := []int{0, 7, 31, 3, 12}
+	stream, err := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDFive, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, _, err := tiny.Fuzzy.ReadStreamHeader(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(header, tiny.FuzzyStreamHeader{Version: tiny.FuzzyStreamVersion, ID: tiny.FuzzyIDFive, Count: 5}, t)
+
+	decoded, remainder, err := tiny.Fuzzy.DecodeStream(stream.AppendBits(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded, values, t)
+	CompareValues(remainder.StringBinary(), "10", t)
+}
+
+func Test_Fuzzy_Stream_Concatenated(t *testing.T) {
+	a, _ := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDGamma, []int{1, 2, 300})
+	b, _ := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDSixtyFour, []int{64, 0})
+
+	first, remainder, err := tiny.Fuzzy.DecodeStream(a.Append(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, remainder, err := tiny.Fuzzy.DecodeStream(remainder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(first, []int{1, 2, 300}, t)
+	CompareSlices(second, []int{64, 0}, t)
+	CompareValues(remainder.BitLength(), 0, t)
+}
+
+func Test_Fuzzy_Stream_Power(t *testing.T) {
+	powers := []int{1, 5, 64, 17, 2}
+	stream, err := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDPower, powers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, remainder, err := tiny.Fuzzy.DecodeStream(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded, powers, t)
+	CompareValues(remainder.BitLength(), 0, t)
+}
+
+func Test_Fuzzy_Stream_Custom(t *testing.T) {
+	id := tiny.FuzzyIDCustom + 7
+	rice := tiny.Fuzzy.Rice(3)
+	if err := tiny.Fuzzy.Register(id, rice); err != nil {
+		t.Fatal(err)
+	}
+	if err := tiny.Fuzzy.Register(id, rice); !errors.Is(err, tiny.ErrorFuzzyRegistry) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyRegistry, err)
+	}
+	if err := tiny.Fuzzy.Register(tiny.FuzzyIDGamma, rice); !errors.Is(err, tiny.ErrorFuzzyRegistry) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyRegistry, err)
+	}
+
+	stream, err := tiny.Fuzzy.EncodeStream(id, []int{9, 0, 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, _, err := tiny.Fuzzy.DecodeStream(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded, []int{9, 0, 100}, t)
+}
+
+func Test_Fuzzy_Stream_Errors(t *testing.T) {
+	var unknown tiny.UnknownFuzzyIDError
+	_, err := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDCustom+999, []int{1})
+	if !errors.As(err, &unknown) || unknown.ID != tiny.FuzzyIDCustom+999 {
+		t.Errorf("Expected an unknown ID error, got %v", err)
+	}
+
+	stream, _ := tiny.Fuzzy.EncodeStream(tiny.FuzzyIDFive, []int{1, 2})
+	// Each field of this header is a single byte, the second being the ID
+	_, body, _ := stream.Read(24)
+	_, _, err = tiny.Fuzzy.DecodeStream(tiny.NewPhrase(1, 100, 2).Append(body))
+	if !errors.As(err, &unknown) || unknown.ID != 100 {
+		t.Errorf("Expected an unknown ID error, got %v", err)
+	}
+
+	_, _, err = tiny.Fuzzy.DecodeStream(tiny.NewPhrase(2, 1, 0))
+	if !errors.Is(err, tiny.ErrorFuzzyStream) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyStream, err)
+	}
+
+	_, _, err = tiny.Fuzzy.DecodeStream(tiny.NewPhrase(1, 2, 3).AppendBits(1, 0, 0))
+	if !errors.Is(err, tiny.ErrorEndOfBits) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorEndOfBits, err)
+	}
+}
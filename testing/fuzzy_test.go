@@ -0,0 +1,444 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func fiveTable(cumulative bool) tiny.FuzzyTable {
+	return tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Width: 1},
+			{Width: 2},
+			{Width: 3},
+			{Width: 4},
+			{Width: 5},
+		},
+		Cumulative: cumulative,
+	}
+}
+
+func Test_Fuzzy_NewScheme(t *testing.T) {
+	scheme, err := tiny.Fuzzy.NewScheme(fiveTable(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high := scheme.Range()
+	CompareValues(low, 0, t)
+	CompareValues(high, 31, t)
+
+	key, projection := scheme.Encode(5)
+	CompareValues(key.StringBinary(), "001", t)
+	CompareValues(projection.StringBinary(), "101", t)
+
+	key, projection = scheme.Encode(31)
+	CompareValues(key.StringBinary(), "0000", t)
+	CompareValues(projection.StringBinary(), "11111", t)
+}
+
+func Test_Fuzzy_NewScheme_Cumulative(t *testing.T) {
+	scheme, err := tiny.Fuzzy.NewScheme(fiveTable(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high := scheme.Range()
+	CompareValues(low, 0, t)
+	CompareValues(high, 61, t)
+
+	key, projection := scheme.Encode(14)
+	CompareValues(key.StringBinary(), "0001", t)
+	CompareValues(projection.StringBinary(), "0000", t)
+
+	encode, read := scheme.Funcs()
+	for i := low; i <= high; i++ {
+		key, projection := encode(i)
+		value, remainder := read(key.Append(projection).AppendBits(1, 0, 1))
+		CompareValues(value, i, t)
+		CompareValues(remainder.StringBinary(), "101", t)
+	}
+}
+
+func Test_Fuzzy_NewScheme_Transform(t *testing.T) {
+	scheme, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Key: tiny.From.Bits(1), Width: 2, Offset: 1, Transform: func(v int) int { return v * 10 }, Inverse: func(v int) int { return v / 10 }},
+			{Key: tiny.From.Bits(0), Width: 8},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high := scheme.Range()
+	CompareValues(low, 0, t)
+	CompareValues(high, 255, t)
+
+	key, projection := scheme.Encode(40)
+	CompareValues(key.StringBinary(), "1", t)
+	CompareValues(projection.StringBinary(), "11", t)
+
+	key, projection = scheme.Encode(41)
+	CompareValues(key.StringBinary(), "0", t)
+	CompareValues(projection.StringBinary(), "00101001", t)
+
+	value, _ := scheme.Read(tiny.NewPhraseFromString("111"))
+	CompareValues(value, 40, t)
+}
+
+func Test_Fuzzy_NewScheme_NotPrefixFree(t *testing.T) {
+	_, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Key: tiny.From.Bits(0), Width: 2},
+			{Key: tiny.From.Bits(0, 1), Width: 4},
+		},
+	})
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+}
+
+func Test_Fuzzy_NewScheme_Empty(t *testing.T) {
+	_, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{})
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+}
+
+func Test_Fuzzy_NewScheme_TooWide(t *testing.T) {
+	for _, width := range []int{tiny.GetArchitectureBitWidth() - 1, tiny.GetArchitectureBitWidth()} {
+		_, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{Rows: []tiny.FuzzyRow{{Width: width}}})
+		if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+			t.Errorf("Expected %v for a width of %d, got %v", tiny.ErrorFuzzyScheme, width, err)
+		}
+	}
+}
+
+func Test_Fuzzy_NewScheme_OffsetOverflow(t *testing.T) {
+	widest := tiny.GetArchitectureBitWidth() - 2
+	_, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows:       []tiny.FuzzyRow{{Width: widest}, {Width: widest}, {Width: 4}},
+		Cumulative: true,
+	})
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+
+	_, err = tiny.Fuzzy.NewScheme(tiny.FuzzyTable{Rows: []tiny.FuzzyRow{{Width: 4, Offset: math.MaxInt - 4}}})
+	if !errors.Is(err, tiny.ErrorFuzzyScheme) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyScheme, err)
+	}
+
+	// The widest rows which fit are still accepted
+	scheme, err := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{Rows: []tiny.FuzzyRow{{Width: widest}, {Width: widest}}, Cumulative: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	low, high := scheme.Range()
+	CompareValues(low, 0, t)
+	CompareValues(high, math.MaxInt, t)
+}
+
+func Test_Fuzzy_NewScheme_ShouldPanicOutOfRange(t *testing.T) {
+	defer ShouldPanic(t)
+	scheme, _ := tiny.Fuzzy.NewScheme(fiveTable(false))
+	scheme.Encode(32)
+}
+
+func verifyFuzzy(t *testing.T, encode tiny.FuzzyEncodeFunc, read tiny.FuzzyReadFunc, min int, max int, samples ...int) {
+	for _, m := range tiny.Fuzzy.Verify(encode, read, min, max, samples...) {
+		t.Error(m)
+	}
+}
+
+func Test_Fuzzy_Verify_SixtyFour(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.SixtyFour.Encode, tiny.Fuzzy.SixtyFour.Read, 0, 1<<12)
+	verifyFuzzy(t, tiny.Fuzzy.SixtyFour.Encode, tiny.Fuzzy.SixtyFour.Read, 0, math.MaxInt, 1<<12)
+}
+
+func Test_Fuzzy_Verify_Five(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.Five.Encode, tiny.Fuzzy.Five.Read, 0, 31)
+}
+
+func Test_Fuzzy_Verify_FiveCumulative(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.FiveCumulative.Encode, tiny.Fuzzy.FiveCumulative.Read, 0, 61)
+}
+
+func Test_Fuzzy_Verify_Power(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.Power.Encode, tiny.Fuzzy.Power.ReadPower, 1, 64)
+}
+
+func Test_Fuzzy_Verify_Byte(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.Byte.Encode, tiny.Fuzzy.Byte.Read, 0, 255)
+}
+
+func Test_Fuzzy_Verify_ZLE(t *testing.T) {
+	verifyFuzzy(t, tiny.Fuzzy.ZLE.Encode, tiny.Fuzzy.ZLE.Read, 0, 1<<12)
+	verifyFuzzy(t, tiny.Fuzzy.ZLE.Encode, tiny.Fuzzy.ZLE.Read, 0, math.MaxInt, 1<<12)
+}
+
+func Test_Fuzzy_Verify_ZLE_EveryKey(t *testing.T) {
+	// Each key length is walked exhaustively, up to the widest projection it allows
+	for zeros := 0; zeros <= 4; zeros++ {
+		limited := tiny.Fuzzy.ZLE.WithMaxKey(zeros)
+		verifyFuzzy(t, limited.Encode, limited.Read, 0, 1<<(1<<zeros)-1)
+	}
+}
+
+func Test_Fuzzy_ZLE(t *testing.T) {
+	key, projection := tiny.Fuzzy.ZLE.Encode(1)
+	CompareValues(key.StringBinary(), "1", t)
+	CompareValues(projection.StringBinary(), "1", t)
+
+	key, projection = tiny.Fuzzy.ZLE.Encode(9)
+	CompareValues(key.StringBinary(), "001", t)
+	CompareValues(projection.StringBinary(), "1001", t)
+}
+
+func Test_Fuzzy_ZLE_Big(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(3), 1000)
+	key, projection := tiny.Fuzzy.ZLE.EncodeBig(huge)
+	CompareValues(key.BitLength(), 11, t)
+	CompareValues(projection.BitLength(), 1024, t)
+
+	value, remainder := tiny.Fuzzy.ZLE.ReadBig(key.Append(projection).AppendBits(1, 0))
+	CompareValues(value.Cmp(huge), 0, t)
+	CompareValues(remainder.StringBinary(), "10", t)
+}
+
+func Test_Fuzzy_ZLE_Overflow(t *testing.T) {
+	key, projection := tiny.Fuzzy.ZLE.EncodeBig(new(big.Int).Lsh(big.NewInt(1), 100))
+	data := key.Append(projection)
+
+	value, remainder, err := tiny.Fuzzy.ZLE.ReadStrict(data)
+	if !errors.Is(err, tiny.ErrorFuzzyOverflow) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyOverflow, err)
+	}
+	CompareValues(value, 0, t)
+	CompareValues(remainder.BitLength(), data.BitLength(), t)
+
+	value, remainder = tiny.Fuzzy.ZLE.Read(data)
+	CompareValues(value, 0, t)
+	CompareValues(remainder.BitLength(), data.BitLength(), t)
+
+	if _, err = tiny.Fuzzy.DecodeAll(tiny.Fuzzy.ZLE, data); !errors.Is(err, tiny.ErrorFuzzyOverflow) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyOverflow, err)
+	}
+}
+
+func Test_Fuzzy_ZLE_MaxKey(t *testing.T) {
+	limited := tiny.Fuzzy.ZLE.WithMaxKey(2)
+	key, projection := tiny.Fuzzy.ZLE.Encode(1 << 20)
+	data := key.Append(projection)
+
+	value, remainder := limited.Read(data)
+	CompareValues(value, 0, t)
+	CompareValues(remainder.BitLength(), data.BitLength(), t)
+
+	_, err := tiny.Fuzzy.DecodeAll(limited, tiny.Synthesize.Zeros(1<<20))
+	if !errors.Is(err, tiny.ErrorFuzzyKey) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyKey, err)
+	}
+	verifyFuzzy(t, limited.Encode, limited.Read, 0, 15)
+}
+
+func Test_Fuzzy_ZLE_MaxKey_ShouldPanicEncodingPastLimit(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Fuzzy.ZLE.WithMaxKey(2).Encode(16)
+}
+
+func Test_Fuzzy_Verify_Scheme(t *testing.T) {
+	scheme, _ := tiny.Fuzzy.NewScheme(fiveTable(true))
+	encode, read := scheme.Funcs()
+	low, high := scheme.Range()
+	verifyFuzzy(t, encode, read, low, high)
+}
+
+func Test_Fuzzy_Verify_ReportsMismatches(t *testing.T) {
+	mismatches := tiny.Fuzzy.Verify(tiny.Fuzzy.Five.Encode, tiny.Fuzzy.Five.Read, 30, 33)
+	CompareValues(len(mismatches), 2, t)
+	CompareValues(mismatches[0].Value, 32, t)
+	if mismatches[0].Err == nil {
+		t.Error("Expected the mismatch to hold an error")
+	}
+}
+
+func Test_Fuzzy_Verify_ReplaysSeed(t *testing.T) {
+	mismatches := tiny.Fuzzy.Verify(tiny.Fuzzy.Five.Encode, tiny.Fuzzy.Five.Read, 0, 1000, 64)
+	if len(mismatches) == 0 {
+		t.Fatal("Expected mismatches beyond the map's range")
+	}
+	seed := mismatches[0].Seed
+
+	replayed := tiny.Fuzzy.VerifySeeded(tiny.Fuzzy.Five.Encode, tiny.Fuzzy.Five.Read, 0, 1000, 64, seed)
+	CompareValues(len(replayed), len(mismatches), t)
+	for i := range replayed {
+		CompareValues(replayed[i].Value, mismatches[i].Value, t)
+		CompareValues(replayed[i].Seed, seed, t)
+	}
+}
+
+func Test_Fuzzy_VerifySeeded_ShouldPanicWithNoSamples(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Fuzzy.VerifySeeded(tiny.Fuzzy.Five.Encode, tiny.Fuzzy.Five.Read, 0, 31, 0, 1)
+}
+
+func Test_Fuzzy_Power_Read(t *testing.T) {
+	key, projection := tiny.Fuzzy.Power.Encode(5)
+	value, _ := tiny.Fuzzy.Power.Read(key.Append(projection))
+	CompareValues(value, 31, t)
+}
+
+func Test_Fuzzy_EncodeAll(t *testing.T) {
+	values := []int{0, 1, 5, 13, 14, 29, 30, 61}
+	data := tiny.Fuzzy.EncodeAll(tiny.Fuzzy.FiveCumulative, values)
+	CompareValues(data.BitLength(), 2+2+4+6+8+8+9+9, t)
+
+	out, err := tiny.Fuzzy.DecodeAll(tiny.Fuzzy.FiveCumulative, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(out, values, t)
+}
+
+func Test_Fuzzy_DecodeAll_RoundTrip(t *testing.T) {
+	scheme, _ := tiny.Fuzzy.NewScheme(fiveTable(true))
+	low, high := scheme.Range()
+	codecs := []struct {
+		name     string
+		codec    tiny.FuzzyCodec
+		min, max int
+	}{
+		{"SixtyFour", tiny.Fuzzy.SixtyFour, 0, 1 << 20},
+		{"Five", tiny.Fuzzy.Five, 0, 31},
+		{"FiveCumulative", tiny.Fuzzy.FiveCumulative, 0, 61},
+		{"Powers", tiny.Fuzzy.Powers, 1, 64},
+		{"ZLE", tiny.Fuzzy.ZLE, 0, 1 << 20},
+		{"Byte", tiny.Fuzzy.Byte, 0, 255},
+		{"Unary", tiny.Fuzzy.Unary, 0, 100},
+		{"Gamma", tiny.Fuzzy.Gamma, 1, 1 << 20},
+		{"Delta", tiny.Fuzzy.Delta, 1, 1 << 20},
+		{"Omega", tiny.Fuzzy.Omega, 1, 1 << 20},
+		{"Fibonacci", tiny.Fuzzy.Fibonacci, 1, 1 << 20},
+		{"Golomb", tiny.Fuzzy.Golomb(5), 0, 1000},
+		{"ExpGolomb", tiny.Fuzzy.ExpGolomb(2), 0, 1 << 20},
+		{"Scheme", scheme, low, high},
+	}
+
+	for _, c := range codecs {
+		values := []int{c.min, c.max, c.min + (c.max-c.min)/2, c.min + 1, c.max - 1, c.min}
+		out, err := tiny.Fuzzy.DecodeAll(c.codec, tiny.Fuzzy.EncodeAll(c.codec, values))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+		CompareSlices(out, values, t)
+	}
+}
+
+func Test_Fuzzy_DecodeAll_Power(t *testing.T) {
+	data := tiny.Fuzzy.EncodeAll(tiny.Fuzzy.Power, []int{3, 5})
+
+	// Power reads the interpretation of each power, just as its Read does, while Powers reads the powers
+	interpreted, err := tiny.Fuzzy.DecodeAll(tiny.Fuzzy.Power, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, _ := tiny.Fuzzy.Power.Read(data)
+	CompareValues(interpreted[0], first, t)
+	CompareSlices(interpreted, []int{7, 31}, t)
+
+	powers, err := tiny.Fuzzy.DecodeAll(tiny.Fuzzy.Powers, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(powers, []int{3, 5}, t)
+}
+
+func Test_Fuzzy_DecodeAll_Truncated(t *testing.T) {
+	values := []int{3, 200, 1 << 20}
+	data := tiny.Fuzzy.EncodeAll(tiny.Fuzzy.SixtyFour, values)
+	truncated, _, _ := data.Read(data.BitLength() - 1)
+
+	out, err := tiny.Fuzzy.DecodeAll(tiny.Fuzzy.SixtyFour, truncated)
+	if !errors.Is(err, tiny.ErrorEndOfBits) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorEndOfBits, err)
+	}
+	CompareSlices(out, values[:2], t)
+}
+
+func Test_Fuzzy_DecodeAll_TruncatedKey(t *testing.T) {
+	scheme, _ := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Key: tiny.From.Bits(1), Width: 2},
+			{Key: tiny.From.Bits(0, 0, 1), Width: 4},
+			{Key: tiny.From.Bits(0, 0, 0), Width: 6},
+		},
+	})
+	// The final value's key is cut off after its first two bits
+	data := tiny.Fuzzy.EncodeAll(scheme, []int{2, 9}).AppendBits(0, 0)
+
+	out, err := tiny.Fuzzy.DecodeAll(scheme, data)
+	if !errors.Is(err, tiny.ErrorEndOfBits) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorEndOfBits, err)
+	}
+	CompareSlices(out, []int{2, 9}, t)
+}
+
+func Test_Fuzzy_ReadStrict_Truncated(t *testing.T) {
+	scheme, _ := tiny.Fuzzy.NewScheme(fiveTable(true))
+	codecs := map[string]tiny.FuzzyStrictCodec{
+		"SixtyFour":      tiny.Fuzzy.SixtyFour,
+		"Five":           tiny.Fuzzy.Five,
+		"FiveCumulative": tiny.Fuzzy.FiveCumulative,
+		"Power":          tiny.Fuzzy.Power,
+		"Powers":         tiny.Fuzzy.Powers,
+		"ZLE":            tiny.Fuzzy.ZLE,
+		"Byte":           tiny.Fuzzy.Byte,
+		"Unary":          tiny.Fuzzy.Unary,
+		"Gamma":          tiny.Fuzzy.Gamma,
+		"Delta":          tiny.Fuzzy.Delta,
+		"Omega":          tiny.Fuzzy.Omega,
+		"Fibonacci":      tiny.Fuzzy.Fibonacci,
+		"Golomb":         tiny.Fuzzy.Golomb(3),
+		"ExpGolomb":      tiny.Fuzzy.ExpGolomb(2),
+		"Scheme":         scheme,
+	}
+
+	for name, codec := range codecs {
+		key, projection := codec.Encode(5)
+		data := key.Append(projection)
+
+		value, remainder, err := codec.ReadStrict(data)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+		CompareValues(remainder.BitLength(), 0, t)
+		expected, _ := codec.Read(data)
+		CompareValues(value, expected, t)
+
+		// Every value cut short, even within its key, is reported as such
+		for length := 1; length < data.BitLength(); length++ {
+			truncated, _, _ := data.Read(length)
+			if _, _, err = codec.ReadStrict(truncated); !errors.Is(err, tiny.ErrorEndOfBits) {
+				t.Errorf("%s: expected %v after %d bits, got %v", name, tiny.ErrorEndOfBits, length, err)
+			}
+		}
+	}
+}
+
+func Test_Fuzzy_DecodeAll_UnknownKey(t *testing.T) {
+	scheme, _ := tiny.Fuzzy.NewScheme(tiny.FuzzyTable{
+		Rows: []tiny.FuzzyRow{
+			{Key: tiny.From.Bits(1), Width: 2},
+			{Key: tiny.From.Bits(0, 1), Width: 4},
+		},
+	})
+	data := tiny.Fuzzy.EncodeAll(scheme, []int{2, 9}).AppendBits(0, 0, 1)
+
+	out, err := tiny.Fuzzy.DecodeAll(scheme, data)
+	if !errors.Is(err, tiny.ErrorFuzzyKey) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorFuzzyKey, err)
+	}
+	CompareSlices(out, []int{2, 9}, t)
+}
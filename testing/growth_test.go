@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func growthWalk(scheme tiny.GrowthScheme, width int, levels int) []int {
+	out := make([]int, 0, levels)
+	for level := 0; level < levels && width > 0; level++ {
+		g := scheme.Grow(level, width)
+		out = append(out, g.Width)
+		width = g.Width - g.Stride
+	}
+	return out
+}
+
+func Test_Growth_Standard(t *testing.T) {
+	CompareSlices(growthWalk(tiny.StandardGrowth, 300, 3), []int{256, 255, 254}, t)
+	CompareSlices(growthWalk(tiny.StandardGrowth, 8, 3), []int{8, 7, 6}, t)
+}
+
+func Test_Growth_Halving(t *testing.T) {
+	CompareSlices(growthWalk(tiny.HalvingGrowth{}, 100, 10), []int{100, 50, 25, 12, 6, 3, 1}, t)
+}
+
+func Test_Growth_Power(t *testing.T) {
+	CompareSlices(growthWalk(tiny.PowerGrowth{}, 100, 10), []int{63, 31, 15, 7, 3, 1}, t)
+}
+
+func Test_Growth_Table(t *testing.T) {
+	table := tiny.TableGrowth{
+		{Width: 0, Stride: 4},
+		{Width: 10, Stride: 2},
+	}
+	CompareSlices(growthWalk(table, 32, 4), []int{32, 10, 8, 6}, t)
+}
+
+func Test_Growth_Table_Movement(t *testing.T) {
+	table := tiny.TableGrowth{{Movement: 0}, {Movement: 1}, {Movement: 0}}
+	CompareValues(table.Grow(0, 32).Movement, 0, t)
+	CompareValues(table.Grow(1, 32).Movement, 1, t)
+	CompareValues(table.Grow(7, 32).Movement, 0, t)
+	CompareValues(tiny.ConstantGrowth{Movement: 2}.Grow(7, 32).Movement, 2, t)
+	CompareValues(tiny.HalvingGrowth{Movement: 3}.Grow(0, 32).Movement, 3, t)
+	CompareValues(tiny.PowerGrowth{Movement: 4}.Grow(0, 32).Movement, 4, t)
+}
+
+func Test_Growth_Table_ShouldPanicWhenEmpty(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.TableGrowth{}.Grow(0, 32)
+}
+
+func Test_Growth_Passage(t *testing.T) {
+	target := tiny.NewPhrase(77, 22, 33)
+	for _, scheme := range []tiny.GrowthScheme{tiny.StandardGrowth, tiny.HalvingGrowth{}, tiny.PowerGrowth{}} {
+		passage := tiny.Synthesize.Passage(target, 2, scheme)
+		CompareValues(passage.Perform().AsBigInt().String(), target.AsBigInt().String(), t)
+	}
+}
+
+func Test_Growth_Composition(t *testing.T) {
+	seed := tiny.NewPhraseFromString("000001011000")
+	c := tiny.NewComposition(seed, trimLeading, trimTrailing)
+	c.Scheme = tiny.ConstantGrowth{Movement: 1}
+
+	result, pathway, err := c.Perform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(result.StringBinary(), "000001011", t)
+	CompareValues(pathway.Depth(), 1, t)
+	CompareValues(pathway[0].Movement, 1, t)
+}
+
+// oddGrowth is a custom scheme which strides by three bits, and performs movement 1, at odd widths.
+type oddGrowth struct{}
+
+func (oddGrowth) Grow(level int, width int) tiny.Growth {
+	return tiny.Growth{Width: width, Stride: 1 + 2*(width%2), Movement: width % 2}
+}
+
+func Test_Growth_Passage_DNA(t *testing.T) {
+	target := tiny.NewPhrase(77, 22, 33)
+	for _, scheme := range []tiny.GrowthScheme{nil, tiny.StandardGrowth, tiny.HalvingGrowth{}, tiny.PowerGrowth{}, oddGrowth{}} {
+		passage := tiny.Synthesize.Passage(target, 2, scheme)
+
+		buf := new(bytes.Buffer)
+		if err := tiny.WriteDNA(buf, passage.DNA()); err != nil {
+			t.Fatal(err)
+		}
+		dna, err := tiny.ReadDNA(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rebuilt, err := tiny.NewPassageFromDNA(dna)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		CompareSlices(rebuilt.Pathway(), passage.Pathway(), t)
+		CompareValues(rebuilt.Perform().AsBigInt().String(), target.AsBigInt().String(), t)
+	}
+}
+
+func Test_Growth_Passage_DNA_NoWidths(t *testing.T) {
+	passage := tiny.Synthesize.Passage(tiny.NewPhraseFromString("101"), 8)
+	rebuilt, err := tiny.NewPassageFromDNA(passage.DNA())
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(rebuilt.Pathway().Depth(), 0, t)
+	CompareValues(rebuilt.Perform().AsBigInt().String(), passage.Perform().AsBigInt().String(), t)
+}
+
+func Test_Growth_Passage_DNA_Widening(t *testing.T) {
+	_, err := tiny.NewPassageFromDNA(tiny.DNA{Pathway: tiny.Pathway{
+		{InputWidth: 10, OutputWidth: 8},
+		{InputWidth: 9, OutputWidth: 7},
+	}})
+	if !errors.Is(err, tiny.ErrorDNAFormat) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorDNAFormat, err)
+	}
+}
@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"math"
+	"testing"
+)
+
+func Test_Histogram_Aligned(t *testing.T) {
+	h := tiny.Analyze.Histogram(tiny.NewPhraseFromString("1011010"), tiny.WidthCrumb, tiny.WidthCrumb)
+	CompareValues(h.Total, 3, t)
+	CompareValues(h.Count(1), 1, t)
+	CompareValues(h.Count(2), 1, t)
+	CompareValues(h.Count(3), 1, t)
+	CompareValues(h.Count(0), 0, t)
+	CompareSlices(h.Symbols(), []int{1, 2, 3}, t)
+}
+
+func Test_Histogram_Sliding(t *testing.T) {
+	h := tiny.Analyze.Histogram(tiny.NewPhraseFromString("101101"), tiny.WidthCrumb, 1)
+	CompareValues(h.Total, 5, t)
+	CompareValues(h.Count(1), 2, t)
+	CompareValues(h.Count(2), 2, t)
+	CompareValues(h.Count(3), 1, t)
+	CompareValues(h.Probability(2), 0.4, t)
+}
+
+func Test_Histogram_Scale(t *testing.T) {
+	data := tiny.NewPhraseFromBits(tiny.From.Number(0xABC, tiny.WidthScale)...)
+	data = data.AppendBits(tiny.From.Number(0xABC, tiny.WidthScale)...)
+	data = data.AppendBits(tiny.From.Number(0x123, tiny.WidthScale)...)
+	h := tiny.Analyze.Histogram(data, tiny.WidthScale, tiny.WidthScale)
+	CompareValues(h.Total, 3, t)
+	CompareValues(h.Count(0xABC), 2, t)
+	CompareValues(h.Count(0x123), 1, t)
+}
+
+func Test_Histogram_ShortData(t *testing.T) {
+	h := tiny.Analyze.Histogram(tiny.NewPhraseFromString("101"), tiny.WidthNibble, 1)
+	CompareValues(h.Total, 0, t)
+	perSymbol, perBit := tiny.Analyze.Entropy(h)
+	CompareValues(perSymbol, 0.0, t)
+	CompareValues(perBit, 0.0, t)
+}
+
+func Test_Histogram_ShouldPanicWithZeroWidth(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Analyze.Histogram(tiny.NewPhraseFromString("101"), 0, 1)
+}
+
+func Test_Histogram_ShouldPanicWithZeroStep(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Analyze.Histogram(tiny.NewPhraseFromString("101"), 1, 0)
+}
+
+func Test_Entropy_Uniform(t *testing.T) {
+	// Every Note appears once
+	data := tiny.NewPhrase()
+	for v := 0; v <= tiny.MaxNote; v++ {
+		data = data.AppendBits(tiny.From.Number(v, tiny.WidthNote)...)
+	}
+	perSymbol, perBit := tiny.Analyze.Entropy(tiny.Analyze.Histogram(data, tiny.WidthNote, tiny.WidthNote))
+	CompareValues(perSymbol, 3.0, t)
+	CompareValues(perBit, 1.0, t)
+}
+
+func Test_Entropy_Constant(t *testing.T) {
+	perSymbol, perBit := tiny.Analyze.Entropy(tiny.Analyze.Histogram(tiny.Synthesize.Ones(64), tiny.WidthByte, tiny.WidthByte))
+	CompareValues(perSymbol, 0.0, t)
+	CompareValues(perBit, 0.0, t)
+}
+
+func Test_Entropy_Skewed(t *testing.T) {
+	// Three ones and one zero - H = -(¾ log₂ ¾ + ¼ log₂ ¼)
+	perSymbol, perBit := tiny.Analyze.Entropy(tiny.Analyze.Histogram(tiny.NewPhraseFromString("1101"), tiny.WidthBit, 1))
+	expected := -(0.75*math.Log2(0.75) + 0.25*math.Log2(0.25))
+	CompareValues(math.Abs(perSymbol-expected) < 1e-12, true, t)
+	CompareValues(perBit, perSymbol, t)
+}
+
+func Test_Entropy_BoundsHuffman(t *testing.T) {
+	data := tiny.NewPhraseFromString("0000000000000000010101011010101111111111000000000000000000000000")
+	h := tiny.Analyze.Histogram(data, tiny.WidthCrumb, tiny.WidthCrumb)
+	huffman, err := tiny.NewHuffmanFromHistogram(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := huffman.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := huffman.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(decoded.StringBinary(), data.StringBinary(), t)
+
+	// Huffman coding can never beat the entropy, and is within one bit per symbol of it
+	perSymbol, _ := tiny.Analyze.Entropy(h)
+	average := float64(encoded.BitLength()) / float64(h.Total)
+	CompareValues(average >= perSymbol, true, t)
+	CompareValues(average < perSymbol+1, true, t)
+}
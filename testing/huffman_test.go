@@ -0,0 +1,160 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func Test_Huffman_Canonical(t *testing.T) {
+	h, err := tiny.NewHuffman(tiny.WidthCrumb, map[int]int{0: 10, 1: 5, 2: 2, 3: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"0", "10", "110", "111"}
+	for symbol, code := range expected {
+		c, ok := h.Code(symbol)
+		if !ok {
+			t.Fatalf("Expected a code for %d", symbol)
+		}
+		CompareValues(c.StringBinary(), code, t)
+	}
+}
+
+func Test_Huffman_RoundTrip(t *testing.T) {
+	data := tiny.NewPhraseFromString("000001000000111010000001000011000000")
+	frequencies := make(map[int]int)
+	for _, v := range []int{0, 1, 0, 0, 7, 2, 0, 1, 0, 3, 0, 0} {
+		frequencies[v]++
+	}
+
+	h, err := tiny.NewHuffman(tiny.WidthNote, frequencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := h.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encoded.BitLength() >= data.BitLength() {
+		t.Errorf("Expected the encoding to shrink %d bits, got %d", data.BitLength(), encoded.BitLength())
+	}
+
+	decoded, err := h.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded.Bits(), data.Bits(), t)
+}
+
+func Test_Huffman_LengthLimited(t *testing.T) {
+	// Fibonacci frequencies produce the deepest possible tree
+	frequencies := make(map[int]int)
+	a, b := 1, 1
+	for symbol := 0; symbol < 16; symbol++ {
+		frequencies[symbol] = a
+		a, b = b, a+b
+	}
+
+	unlimited, err := tiny.NewHuffman(tiny.WidthNibble, frequencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	longest, _ := unlimited.Code(0)
+	CompareValues(longest.BitLength(), 15, t)
+
+	limited, err := tiny.NewHuffman(tiny.WidthNibble, frequencies, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kraft := 0.0
+	for symbol := range frequencies {
+		code, _ := limited.Code(symbol)
+		if code.BitLength() > 5 {
+			t.Errorf("Expected symbol %d to have a code of at most 5 bits, got %d", symbol, code.BitLength())
+		}
+		kraft += 1.0 / float64(int(1)<<code.BitLength())
+	}
+	CompareValues(kraft, 1.0, t)
+
+	data := tiny.NewPhraseFromString("00001111010110100000")
+	encoded, _ := limited.Encode(data)
+	decoded, err := limited.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded.Bits(), data.Bits(), t)
+
+	_, err = tiny.NewHuffman(tiny.WidthNibble, frequencies, 3)
+	if !errors.Is(err, tiny.ErrorHuffmanTable) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorHuffmanTable, err)
+	}
+}
+
+func Test_Huffman_Table(t *testing.T) {
+	h, err := tiny.NewHuffman(12, map[int]int{4000: 7, 12: 3, 99: 3, 1: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, remainder, err := tiny.ReadHuffman(h.Table().AppendBits(1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(read.Width(), 12, t)
+	CompareValues(remainder.StringBinary(), "11", t)
+	for _, symbol := range []int{4000, 12, 99, 1} {
+		a, _ := h.Code(symbol)
+		b, _ := read.Code(symbol)
+		CompareValues(b.StringBinary(), a.StringBinary(), t)
+	}
+
+	_, _, err = tiny.ReadHuffman(tiny.NewPhraseFromString("0000"))
+	if !errors.Is(err, tiny.ErrorHuffmanTable) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorHuffmanTable, err)
+	}
+}
+
+func Test_Huffman_Errors(t *testing.T) {
+	h, err := tiny.NewHuffman(tiny.WidthCrumb, map[int]int{0: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = h.Encode(tiny.NewPhraseFromString("01"))
+	if !errors.Is(err, tiny.ErrorHuffmanSymbol) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorHuffmanSymbol, err)
+	}
+	_, err = h.Decode(tiny.NewPhraseFromString("1"))
+	if !errors.Is(err, tiny.ErrorHuffmanCode) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorHuffmanCode, err)
+	}
+
+	two, _ := tiny.NewHuffman(tiny.WidthCrumb, map[int]int{0: 4, 1: 2, 2: 1, 3: 1})
+	_, err = two.Decode(tiny.NewPhraseFromString("011"))
+	if !errors.Is(err, tiny.ErrorEndOfBits) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorEndOfBits, err)
+	}
+}
+
+func Test_Huffman_WideAlphabet(t *testing.T) {
+	frequencies := make(map[int]int)
+	for symbol := 0; symbol < 1<<12; symbol++ {
+		frequencies[symbol] = 1 + symbol%97
+	}
+	h, err := tiny.NewHuffman(12, frequencies, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := tiny.Synthesize.RandomPhrase(240, 12)
+	encoded, err := h.Encode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := h.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded.Bits(), data.Bits(), t)
+}
@@ -0,0 +1,185 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"io"
+	"math/big"
+	"testing"
+)
+
+func Test_LEB128_Unsigned(t *testing.T) {
+	encoded := tiny.From.ULEB128(big.NewInt(624485))
+	CompareSlices(encoded.AsBytes(), []byte{0xE5, 0x8E, 0x26}, t)
+	CompareSlices(tiny.From.ULEB128(big.NewInt(0)).AsBytes(), []byte{0x00}, t)
+
+	value, remainder, err := tiny.To.ULEB128(encoded.AppendBits(1, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(value.Int64(), 624485, t)
+	CompareValues(remainder.StringBinary(), "101", t)
+}
+
+func Test_LEB128_Signed(t *testing.T) {
+	encoded := tiny.From.SLEB128(big.NewInt(-123456))
+	CompareSlices(encoded.AsBytes(), []byte{0xC0, 0xBB, 0x78}, t)
+	CompareSlices(tiny.From.SLEB128(big.NewInt(63)).AsBytes(), []byte{0x3F}, t)
+	CompareSlices(tiny.From.SLEB128(big.NewInt(64)).AsBytes(), []byte{0xC0, 0x00}, t)
+	CompareSlices(tiny.From.SLEB128(big.NewInt(-64)).AsBytes(), []byte{0x40}, t)
+
+	for _, v := range []int64{0, 1, -1, 63, 64, -64, -65, 127, 128, -123456, 1 << 40, -(1 << 40)} {
+		value, _, err := tiny.To.SLEB128(tiny.From.SLEB128(big.NewInt(v)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		CompareValues(value.Int64(), v, t)
+	}
+}
+
+func Test_LEB128_Big(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	value, _, err := tiny.To.SLEB128(tiny.From.SLEB128(huge))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(value.Cmp(huge), 0, t)
+
+	huge.Neg(huge)
+	value, _, err = tiny.To.ULEB128(tiny.From.ULEB128(huge))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(value.Cmp(huge), 0, t)
+}
+
+func Test_LEB128_Unaligned(t *testing.T) {
+	encoded := tiny.From.ULEB128(big.NewInt(300)).PrependBits(1, 1, 0)
+	_, data, _ := encoded.Read(3)
+	value, _, err := tiny.To.ULEB128(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(value.Int64(), 300, t)
+}
+
+func Test_LEB128_Overlong(t *testing.T) {
+	value, _, err := tiny.To.ULEB128(tiny.NewPhrase(0x80, 0x00))
+	if !errors.Is(err, tiny.ErrorLEB128Overlong) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLEB128Overlong, err)
+	}
+	CompareValues(value.Int64(), 0, t)
+
+	_, _, err = tiny.To.SLEB128(tiny.NewPhrase(0xFF, 0x7F))
+	if !errors.Is(err, tiny.ErrorLEB128Overlong) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLEB128Overlong, err)
+	}
+
+	_, _, err = tiny.To.SLEB128(tiny.NewPhrase(0xC0, 0x00))
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func Test_LEB128_Truncated(t *testing.T) {
+	_, _, err := tiny.To.ULEB128(tiny.NewPhrase(0xE5, 0x8E))
+	if !errors.Is(err, tiny.ErrorEndOfBits) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorEndOfBits, err)
+	}
+}
+
+func Test_LEB128_Stream(t *testing.T) {
+	r := bytes.NewReader([]byte{0xE5, 0x8E, 0x26, 0xC0, 0xBB, 0x78})
+	u, err := tiny.To.ULEB128Stream(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := tiny.To.SLEB128Stream(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(u.Int64(), 624485, t)
+	CompareValues(s.Int64(), -123456, t)
+
+	// A stream which ends between values ends cleanly
+	_, err = tiny.To.ULEB128Stream(r)
+	if err != io.EOF {
+		t.Errorf("Expected %v, got %v", io.EOF, err)
+	}
+}
+
+func Test_LEB128_Stream_Truncated(t *testing.T) {
+	_, err := tiny.To.ULEB128Stream(bytes.NewReader([]byte{0xE5, 0x8E}))
+	if !errors.Is(err, tiny.ErrorEndOfBits) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected %v and %v, got %v", tiny.ErrorEndOfBits, io.ErrUnexpectedEOF, err)
+	}
+}
+
+// failingReader yields its bytes, then fails with its error.
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (r *failingReader) ReadByte() (byte, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b, nil
+}
+
+func Test_LEB128_Stream_ReaderError(t *testing.T) {
+	failure := errors.New("disk on fire")
+	_, err := tiny.To.SLEB128Stream(&failingReader{data: []byte{0xC0}, err: failure})
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected %v, got %v", failure, err)
+	}
+
+	_, err = tiny.To.ULEB128Stream(&failingReader{err: failure})
+	if err != failure {
+		t.Errorf("Expected %v, got %v", failure, err)
+	}
+}
+
+func Test_ZigZag(t *testing.T) {
+	signed := []int64{0, -1, 1, -2, 2, 2147483647, -2147483648}
+	unsigned := []int64{0, 1, 2, 3, 4, 4294967294, 4294967295}
+	for i := range signed {
+		CompareValues(tiny.From.ZigZag(big.NewInt(signed[i])).Int64(), unsigned[i], t)
+		CompareValues(tiny.To.ZigZag(big.NewInt(unsigned[i])).Int64(), signed[i], t)
+	}
+}
+
+func Test_ZigZag_SintRoundTrip(t *testing.T) {
+	// A protobuf sint is encoded From and decoded To, just like every other LEB128
+	for _, v := range []int64{0, -1, 63, -64, 64, -65, 1 << 40, -(1 << 40)} {
+		encoded := tiny.From.ULEB128(tiny.From.ZigZag(big.NewInt(v)))
+		decoded, remainder, err := tiny.To.ULEB128(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		CompareValues(tiny.To.ZigZag(decoded).Int64(), v, t)
+		CompareValues(remainder.BitLength(), 0, t)
+	}
+
+	// -1 is the single byte 0x01
+	CompareValues(tiny.From.ULEB128(tiny.From.ZigZag(big.NewInt(-1))).StringBinary(), "00000001", t)
+}
+
+func Test_ULEB128_Wide(t *testing.T) {
+	// 2¹⁰⁰ + 0x55 is wider than a uint64, so each group must be masked from the big value itself
+	v := new(big.Int).Lsh(big.NewInt(1), 100)
+	v.Add(v, big.NewInt(0x55))
+	encoded := tiny.From.ULEB128(v)
+	CompareValues(encoded.BitLength(), 15*8, t)
+	CompareValues(encoded.StringBinary()[:8], "11010101", t)
+
+	decoded, _, err := tiny.To.ULEB128(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(decoded.Cmp(v), 0, t)
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+// lfsrPeriod steps the register until it returns to its starting state, giving up after the limit.
+func lfsrPeriod(l *tiny.LFSR, limit int) int {
+	start := l.State().StringBinary()
+	for i := 1; i <= limit; i++ {
+		l.Next()
+		if l.State().StringBinary() == start {
+			return i
+		}
+	}
+	return -1
+}
+
+func Test_LFSR_PRBS_Period(t *testing.T) {
+	for _, order := range []int{7, 9, 15} {
+		l, err := tiny.NewPRBS(order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		CompareValues(lfsrPeriod(l, 1<<order), 1<<order-1, t)
+	}
+}
+
+func Test_LFSR_PRBS7(t *testing.T) {
+	sequence := tiny.Synthesize.PRBS(7, 127)
+	CompareValues(sequence.StringBinary()[:21], "111111100000010000011", t)
+	CompareValues(tiny.Analyze.BitShade(sequence.Bits()...).Ones, 64, t)
+
+	// The sequence repeats with a period of 127
+	doubled := tiny.Synthesize.PRBS(7, 254).StringBinary()
+	CompareValues(doubled[127:], doubled[:127], t)
+}
+
+func Test_LFSR_PRBS_Wide(t *testing.T) {
+	for _, order := range []int{23, 31} {
+		shade := tiny.Analyze.BitShade(tiny.Synthesize.PRBS(order, 1<<16).Bits()...)
+		if shade.Ones < 31000 || shade.Ones > 34500 {
+			t.Errorf("Expected PRBS%d to be roughly balanced, got %d ones of %d", order, shade.Ones, 1<<16)
+		}
+	}
+}
+
+func Test_LFSR_Galois(t *testing.T) {
+	// x⁵ + x³ + 1 is primitive
+	l, err := tiny.NewLFSR(tiny.NewPhraseFromString("00101"), tiny.NewPhraseFromString("10000"), tiny.GaloisLFSR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(lfsrPeriod(l, 64), 31, t)
+}
+
+func Test_LFSR_Errors(t *testing.T) {
+	_, err := tiny.NewLFSR(tiny.NewPhraseFromString("0110"), tiny.NewPhraseFromString("1111"))
+	if !errors.Is(err, tiny.ErrorLFSR) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLFSR, err)
+	}
+	_, err = tiny.NewLFSR(tiny.NewPhraseFromString("0011"), tiny.NewPhraseFromString("0000"))
+	if !errors.Is(err, tiny.ErrorLFSR) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLFSR, err)
+	}
+	_, err = tiny.NewLFSR(tiny.NewPhraseFromString("0011"), tiny.NewPhraseFromString("111"))
+	if !errors.Is(err, tiny.ErrorLFSR) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLFSR, err)
+	}
+	_, err = tiny.NewPRBS(8)
+	if !errors.Is(err, tiny.ErrorLFSR) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorLFSR, err)
+	}
+}
+
+func Test_Synthesize_DeBruijn(t *testing.T) {
+	CompareValues(tiny.Synthesize.DeBruijn(3).StringBinary(), "00010111", t)
+
+	for n := 1; n <= 12; n++ {
+		sequence := tiny.Synthesize.DeBruijn(n).StringBinary()
+		CompareValues(len(sequence), 1<<n, t)
+
+		cyclic := sequence + sequence[:n-1]
+		seen := make(map[string]bool)
+		for i := 0; i < len(sequence); i++ {
+			seen[cyclic[i:i+n]] = true
+		}
+		CompareValues(len(seen), 1<<n, t)
+	}
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func testMedley() *tiny.Medley {
+	m := tiny.NewMedley()
+	m.Set("gamma", tiny.NewPhraseFromString("101"))
+	m.Set("alpha", tiny.NewPhrase(77, 22))
+	m.Set("beta", tiny.NewPhraseFromString("1100110011"))
+	return m
+}
+
+func Test_Medley_Order(t *testing.T) {
+	m := testMedley()
+	m.Set("gamma", tiny.NewPhraseFromString("0"))
+	CompareSlices(m.Names(), []string{"gamma", "alpha", "beta"}, t)
+
+	names := make([]string, 0)
+	for name := range m.All() {
+		names = append(names, name)
+	}
+	CompareSlices(names, []string{"gamma", "alpha", "beta"}, t)
+
+	p, ok := m.Get("gamma")
+	CompareValues(ok, true, t)
+	CompareValues(p.StringBinary(), "0", t)
+}
+
+func Test_Medley_Delete(t *testing.T) {
+	m := testMedley()
+	CompareValues(m.Delete("alpha"), true, t)
+	CompareValues(m.Delete("alpha"), false, t)
+	CompareSlices(m.Names(), []string{"gamma", "beta"}, t)
+	CompareValues(m.Len(), 2, t)
+}
+
+func Test_Medley_ZeroValue(t *testing.T) {
+	var m tiny.Medley
+	m.Set("a", tiny.NewPhraseFromString("1"))
+	CompareValues(m.Len(), 1, t)
+}
+
+func Test_Medley_Merge(t *testing.T) {
+	other := tiny.NewMedley()
+	other.Set("alpha", tiny.NewPhraseFromString("1"))
+	other.Set("delta", tiny.NewPhraseFromString("0"))
+
+	merged := testMedley().Merge(other)
+	CompareSlices(merged.Names(), []string{"gamma", "alpha", "beta", "delta"}, t)
+	p, _ := merged.Get("alpha")
+	CompareValues(p.StringBinary(), "1", t)
+}
+
+func Test_Medley_Diff(t *testing.T) {
+	other := testMedley()
+	other.Delete("beta")
+	other.Set("alpha", tiny.NewPhraseFromString("1"))
+	other.Set("gamma", tiny.NewPhraseFromBits(1, 0, 1).Align(1))
+	other.Set("delta", tiny.NewPhraseFromString("0"))
+
+	added, removed, changed := testMedley().Diff(other)
+	CompareSlices(added, []string{"delta"}, t)
+	CompareSlices(removed, []string{"beta"}, t)
+	CompareSlices(changed, []string{"alpha"}, t)
+}
+
+func Test_Medley_Binary(t *testing.T) {
+	m := testMedley()
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := tiny.NewMedley()
+	if err = out.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(out.Names(), m.Names(), t)
+	for name, p := range m.All() {
+		o, _ := out.Get(name)
+		CompareSlices(o.Bits(), p.Bits(), t)
+	}
+}
+
+func Test_Medley_Binary_Truncated(t *testing.T) {
+	data, _ := testMedley().MarshalBinary()
+	err := tiny.NewMedley().UnmarshalBinary(data[:len(data)-1])
+	if !errors.Is(err, tiny.ErrorMedleyFormat) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorMedleyFormat, err)
+	}
+}
+
+func Test_Medley_Binary_DuplicateName(t *testing.T) {
+	m := tiny.NewMedley()
+	m.Set("ab", tiny.NewPhraseFromString("1"))
+	m.Set("cd", tiny.NewPhraseFromString("0"))
+	data, _ := m.MarshalBinary()
+	copy(data[bytes.Index(data, []byte("cd")):], "ab")
+
+	err := tiny.NewMedley().UnmarshalBinary(data)
+	if !errors.Is(err, tiny.ErrorMedleyFormat) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorMedleyFormat, err)
+	}
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"math"
+	"testing"
+)
+
+// The first 100 binary digits of the expansion of π, as used throughout the examples of NIST SP 800-22
+const randomnessPi = "1100100100001111110110101010001000100001011010001100001000110100110001001100011001100010100010111000"
+
+// compareRandomness checks the result's p-values against NIST's published values to six decimal places.
+func compareRandomness(result tiny.RandomnessResult, err error, t *testing.T, expected ...float64) {
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.PValues) != len(expected) {
+		t.Fatalf("%s: expected %d p-values, got %d", result.Test, len(expected), len(result.PValues))
+	}
+	for i, p := range result.PValues {
+		if math.Abs(p-expected[i]) > 0.0000005 {
+			t.Errorf("%s: expected p-value %f, got %f", result.Test, expected[i], p)
+		}
+	}
+}
+
+func Test_Randomness_Monobit(t *testing.T) {
+	result, err := tiny.Analyze.Monobit(tiny.NewPhraseFromString(randomnessPi))
+	compareRandomness(result, err, t, 0.109599)
+	CompareValues(result.Passed, true, t)
+}
+
+func Test_Randomness_BlockFrequency(t *testing.T) {
+	result, err := tiny.Analyze.BlockFrequency(tiny.NewPhraseFromString(randomnessPi), 10)
+	compareRandomness(result, err, t, 0.706438)
+}
+
+func Test_Randomness_Runs(t *testing.T) {
+	result, err := tiny.Analyze.Runs(tiny.NewPhraseFromString(randomnessPi))
+	compareRandomness(result, err, t, 0.500798)
+}
+
+func Test_Randomness_Runs_Prerequisite(t *testing.T) {
+	result, err := tiny.Analyze.Runs(tiny.Synthesize.Ones(100))
+	compareRandomness(result, err, t, 0)
+	CompareValues(result.Passed, false, t)
+}
+
+func Test_Randomness_LongestRun(t *testing.T) {
+	data := tiny.NewPhraseFromString("11001100000101010110110001001100111000000000001001001101010100010001001111010110100000001101011111001100111001101101100010110010")
+	result, err := tiny.Analyze.LongestRun(data)
+	compareRandomness(result, err, t, 0.180609)
+}
+
+func Test_Randomness_Serial(t *testing.T) {
+	result, err := tiny.Analyze.Serial(tiny.NewPhraseFromString("0011011101"), 3)
+	compareRandomness(result, err, t, 0.808792, 0.670320)
+}
+
+func Test_Randomness_ApproximateEntropy(t *testing.T) {
+	result, err := tiny.Analyze.ApproximateEntropy(tiny.NewPhraseFromString(randomnessPi), 2)
+	compareRandomness(result, err, t, 0.235301)
+}
+
+func Test_Randomness_CumulativeSums(t *testing.T) {
+	result, err := tiny.Analyze.CumulativeSums(tiny.NewPhraseFromString(randomnessPi))
+	compareRandomness(result, err, t, 0.219194, 0.114866)
+}
+
+func Test_Randomness_Battery_Random(t *testing.T) {
+	results, err := tiny.Analyze.Randomness(tiny.NewSynthesizer(42).RandomPhrase(1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(len(results), 7, t)
+	for _, result := range results {
+		if !result.Passed {
+			t.Errorf("%s failed with p-values %v", result.Test, result.PValues)
+		}
+	}
+}
+
+func Test_Randomness_Battery_Pattern(t *testing.T) {
+	results, err := tiny.Analyze.Randomness(tiny.Synthesize.Pattern(8192, 1, 1, 0, 1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := 0
+	for _, result := range results {
+		if !result.Passed {
+			failed++
+		}
+	}
+	if failed == 0 {
+		t.Error("expected a repeating pattern to fail the battery")
+	}
+}
+
+func Test_Randomness_Errors(t *testing.T) {
+	_, err := tiny.Analyze.Randomness(tiny.Synthesize.Uniform(127))
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+	_, err = tiny.Analyze.Monobit(tiny.NewPhrase())
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+	_, err = tiny.Analyze.BlockFrequency(tiny.Synthesize.Uniform(9), 10)
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+	_, err = tiny.Analyze.LongestRun(tiny.Synthesize.Uniform(127))
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+	_, err = tiny.Analyze.Serial(tiny.Synthesize.Uniform(100), 1)
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+	_, err = tiny.Analyze.ApproximateEntropy(tiny.Synthesize.Uniform(8), 4)
+	CompareValues(errors.Is(err, tiny.ErrorRandomness), true, t)
+}
@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"math/rand/v2"
+	"testing"
+)
+
+func rangeRoundTrip(t *testing.T, data tiny.Phrase, context tiny.ContextFunc) tiny.Phrase {
+	encoded := tiny.RangeEncode(data, context)
+	decoded, err := tiny.RangeDecode(encoded, data.BitLength(), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareSlices(decoded.Bits(), data.Bits(), t)
+	return encoded
+}
+
+func Test_RangeCoder_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 200; i++ {
+		data := tiny.Synthesize.ForEach(r.IntN(600), func(int) tiny.Bit { return tiny.Bit(r.IntN(2)) })
+		rangeRoundTrip(t, data, nil)
+		rangeRoundTrip(t, data, tiny.OrderContext(3))
+	}
+	rangeRoundTrip(t, tiny.Phrase{}, nil)
+	rangeRoundTrip(t, tiny.Synthesize.Ones(5000), nil)
+	rangeRoundTrip(t, tiny.Synthesize.Zeros(5000), nil)
+}
+
+func Test_RangeCoder_Skewed(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	data := tiny.Synthesize.ForEach(8000, func(int) tiny.Bit {
+		if r.IntN(20) == 0 {
+			return 1
+		}
+		return 0
+	})
+
+	encoded := rangeRoundTrip(t, data, nil)
+	// The entropy of a 1-in-20 source is ~0.286 bits per bit
+	if encoded.BitLength() > 8000*32/100 {
+		t.Errorf("Expected 8000 skewed bits to code in under %d bits, got %d", 8000*32/100, encoded.BitLength())
+	}
+}
+
+func Test_RangeCoder_OrderContext(t *testing.T) {
+	data := tiny.Synthesize.Repeating(1000, 1, 1, 0, 1, 0, 0)
+	order0 := rangeRoundTrip(t, data, nil)
+	order6 := rangeRoundTrip(t, data, tiny.OrderContext(6))
+	if order6.BitLength()*4 > order0.BitLength() {
+		t.Errorf("Expected an order 6 context to code a repeating pattern far better than order 0, got %d and %d bits", order6.BitLength(), order0.BitLength())
+	}
+}
+
+func Test_RangeCoder_CallerContext(t *testing.T) {
+	// Every even bit is a one, every odd bit is random
+	r := rand.New(rand.NewPCG(5, 6))
+	data := tiny.Synthesize.ForEach(4000, func(i int) tiny.Bit {
+		if i%2 == 0 {
+			return 1
+		}
+		return tiny.Bit(r.IntN(2))
+	})
+
+	parity := func(preceding []tiny.Bit) int { return len(preceding) % 2 }
+	models := make(tiny.BitModels)
+	e := tiny.NewRangeEncoder()
+	for i, bit := range data.Bits() {
+		e.Encode(bit, models.Model(i%2))
+	}
+	encoded := e.Finish()
+	CompareSlices(encoded.Bits(), tiny.RangeEncode(data, parity).Bits(), t)
+
+	d, err := tiny.NewRangeDecoder(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	models = make(tiny.BitModels)
+	for i, bit := range data.Bits() {
+		CompareValues(d.Decode(models.Model(i%2)), bit, t)
+	}
+}
+
+func Test_RangeCoder_ZeroValueModel(t *testing.T) {
+	data := tiny.NewSynthesizer(9).Uniform(500).Bits()
+
+	// A zero value model codes exactly as a fresh model does
+	var zero tiny.BitModel
+	fresh := tiny.NewBitModel()
+	a, b := tiny.NewRangeEncoder(), tiny.NewRangeEncoder()
+	for _, bit := range data {
+		a.Encode(bit, &zero)
+		b.Encode(bit, &fresh)
+	}
+	encoded := a.Finish()
+	CompareSlices(encoded.Bits(), b.Finish().Bits(), t)
+
+	d, err := tiny.NewRangeDecoder(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var model tiny.BitModel
+	for _, bit := range data {
+		CompareValues(d.Decode(&model), bit, t)
+	}
+}
+
+func Test_RangeCoder_Unaligned(t *testing.T) {
+	_, err := tiny.RangeDecode(tiny.NewPhraseFromString("101"), 8, nil)
+	if !errors.Is(err, tiny.ErrorRangeCode) {
+		t.Errorf("Expected %v, got %v", tiny.ErrorRangeCode, err)
+	}
+}
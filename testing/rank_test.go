@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"math/big"
+	"testing"
+)
+
+func Test_Analyze_Rank(t *testing.T) {
+	weight, rank := tiny.Analyze.Rank(tiny.NewPhraseFromString("10110"))
+	CompareValues(weight.Int64(), 3, t)
+	CompareValues(rank.Int64(), 6, t)
+
+	weight, rank = tiny.Analyze.Rank(tiny.NewPhraseFromString("0000"))
+	CompareValues(weight.Int64(), 0, t)
+	CompareValues(rank.Int64(), 0, t)
+}
+
+func Test_Analyze_Rank_MatchesAllWithWeight(t *testing.T) {
+	for k := 0; k <= 10; k++ {
+		i := int64(0)
+		for p := range tiny.Synthesize.AllWithWeight(10, k) {
+			weight, rank := tiny.Analyze.Rank(p)
+			CompareValues(weight.Int64(), int64(k), t)
+			CompareValues(rank.Int64(), i, t)
+			ComparePhrases(tiny.Synthesize.Unrank(10, weight, big.NewInt(i)), p, t)
+			i++
+		}
+		CompareValues(i, new(big.Int).Binomial(10, int64(k)).Int64(), t)
+	}
+}
+
+func Test_Synthesize_Unrank_Wide(t *testing.T) {
+	s := tiny.NewSynthesizer(21)
+	for i := 0; i < 20; i++ {
+		data := s.WithShade(500, 123)
+		weight, rank := tiny.Analyze.Rank(data)
+		CompareValues(weight.Int64(), 123, t)
+		CompareSlices(tiny.Synthesize.Unrank(500, weight, rank).Bits(), data.Bits(), t)
+	}
+}
+
+func Test_Synthesize_Unrank_ShouldPanicWithRankOutOfRange(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.Unrank(4, big.NewInt(2), big.NewInt(6))
+}
+
+func Test_Synthesize_Unrank_ShouldPanicWithTooHeavyWeight(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.Unrank(4, big.NewInt(5), big.NewInt(0))
+}
@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func Test_PositionalShade_Scale(t *testing.T) {
+	data := tiny.NewPhraseFromBits(tiny.From.Number(0x801, tiny.WidthScale)...)
+	data = data.AppendBits(tiny.From.Number(0xC01, tiny.WidthScale)...)
+	s := tiny.Analyze.PositionalShade(data, tiny.WidthScale)
+	CompareValues(s.Width, tiny.WidthScale, t)
+	CompareValues(s.Ones, 5, t)
+	CompareValues(s.Zeros, 19, t)
+	CompareValues(s.Total, 24, t)
+	CompareValues(s.Shade, tiny.Grey, t)
+	CompareValues(s.PredominantlyDark, false, t)
+	CompareSlices(s.Distribution, []int{2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}, t)
+}
+
+func Test_PositionalShade_PartialSymbol(t *testing.T) {
+	s := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1111111"), tiny.WidthNibble)
+	CompareValues(s.Shade, tiny.Dark, t)
+	CompareSlices(s.Distribution, []int{2, 2, 2, 1}, t)
+}
+
+func Test_PositionalShade_Empty(t *testing.T) {
+	s := tiny.Analyze.PositionalShade(tiny.NewPhrase(), tiny.WidthNibble)
+	CompareValues(s.Total, 0, t)
+	CompareValues(s.Shade, tiny.Undefined, t)
+	CompareValues(s.PredominantlyDark, false, t)
+	CompareSlices(s.Distribution, []int{0, 0, 0, 0}, t)
+
+	// Merging in a measurement replaces the undefined shade
+	CompareValues(s.Merge(tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("0000"), tiny.WidthNibble)).Shade, tiny.Light, t)
+}
+
+func Test_PositionalShade_IgnoresMeasurementAlignment(t *testing.T) {
+	// The same bits, measured at different widths, shade identically
+	bits := tiny.NewPhraseFromString("101100111000111101").Bits()
+	a := tiny.NewPhraseFromBits(bits...)
+	b := tiny.NewPhraseFromBits(bits[:5]...).AppendBits(bits[5:7]...).AppendBits(bits[7:]...)
+	CompareSlices(tiny.Analyze.PositionalShade(a, tiny.WidthNote).Distribution, tiny.Analyze.PositionalShade(b, tiny.WidthNote).Distribution, t)
+}
+
+func Test_PositionalShade_MatchesBitShade(t *testing.T) {
+	data := tiny.NewSynthesizer(3).Uniform(1000)
+	expected := tiny.Analyze.BitShade(data.Bits()...)
+	s := tiny.Analyze.PositionalShade(data, tiny.WidthByte)
+	CompareValues(s.Ones, expected.Ones, t)
+	CompareValues(s.Zeros, expected.Zeros, t)
+	CompareSlices(s.Distribution, expected.Distribution[:], t)
+}
+
+func Test_PositionalShade_Concurrent(t *testing.T) {
+	data := tiny.NewSynthesizer(5).Uniform(100003)
+	expected := tiny.Analyze.PositionalShade(data, tiny.WidthRiff, 1)
+	for _, workers := range []int{2, 3, 7, 64} {
+		s := tiny.Analyze.PositionalShade(data, tiny.WidthRiff, workers)
+		CompareValues(s.Ones, expected.Ones, t)
+		CompareValues(s.Total, expected.Total, t)
+		CompareSlices(s.Distribution, expected.Distribution, t)
+	}
+	CompareSlices(tiny.Analyze.PositionalShade(data, tiny.WidthRiff).Distribution, expected.Distribution, t)
+}
+
+func Test_PositionalShade_Merge(t *testing.T) {
+	a := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("110000"), tiny.WidthCrumb)
+	b := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("0111"), tiny.WidthCrumb)
+	merged := a.Merge(b)
+	expected := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1100000111"), tiny.WidthCrumb)
+	CompareValues(merged.Ones, expected.Ones, t)
+	CompareValues(merged.Total, expected.Total, t)
+	CompareSlices(merged.Distribution, expected.Distribution, t)
+
+	// A zero value shade begins an accumulation
+	accumulated := tiny.PositionalShade{}.Merge(a).Merge(b)
+	CompareValues(accumulated.Width, tiny.WidthCrumb, t)
+	CompareSlices(accumulated.Distribution, expected.Distribution, t)
+
+	// Merging doesn't alter either shade
+	CompareSlices(a.Distribution, []int{1, 1}, t)
+}
+
+func Test_PositionalShade_Merge_ShouldPanicWithMismatchedWidths(t *testing.T) {
+	defer ShouldPanic(t)
+	a := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1100"), tiny.WidthCrumb)
+	b := tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1100"), tiny.WidthNote)
+	a.Merge(b)
+}
+
+func Test_PositionalShade_ShouldPanicWithZeroWidth(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1100"), 0)
+}
+
+func Test_PositionalShade_ShouldPanicWithZeroWorkers(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Analyze.PositionalShade(tiny.NewPhraseFromString("1100"), 2, 0)
+}
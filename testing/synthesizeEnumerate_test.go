@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"slices"
+	"testing"
+)
+
+func Test_Synthesize_All(t *testing.T) {
+	i := 0
+	for p := range tiny.Synthesize.All(4) {
+		CompareValues(p.BitLength(), 4, t)
+		CompareValues(tiny.To.Number(4, p.Bits()...), i, t)
+		i++
+	}
+	CompareValues(i, 16, t)
+}
+
+func Test_Synthesize_All_Gray(t *testing.T) {
+	seen := make(map[int]bool)
+	var previous []tiny.Bit
+	for p := range tiny.Synthesize.All(5, tiny.GrayOrder) {
+		bits := p.Bits()
+		seen[tiny.To.Number(5, bits...)] = true
+		if previous != nil {
+			changed := 0
+			for i := range bits {
+				if bits[i] != previous[i] {
+					changed++
+				}
+			}
+			CompareValues(changed, 1, t)
+		}
+		previous = bits
+	}
+	CompareValues(len(seen), 32, t)
+}
+
+func Test_Synthesize_AllRange_Resume(t *testing.T) {
+	var stopped int
+	for i := range tiny.Synthesize.AllRange(8, 0, 256) {
+		if i == 100 {
+			stopped = i
+			break
+		}
+	}
+
+	resumed := make([]int, 0)
+	for i, p := range tiny.Synthesize.AllRange(8, stopped+1, 256) {
+		CompareValues(tiny.To.Number(8, p.Bits()...), i, t)
+		resumed = append(resumed, i)
+	}
+	CompareValues(resumed[0], 101, t)
+	CompareValues(len(resumed), 155, t)
+}
+
+func Test_Synthesize_Partition(t *testing.T) {
+	for _, shards := range []int{1, 3, 7, 64} {
+		indices := make([]int, 0)
+		sizes := make([]int, 0)
+		for _, shard := range tiny.Synthesize.Partition(5, shards, tiny.GrayOrder) {
+			size := 0
+			for i := range shard {
+				indices = append(indices, i)
+				size++
+			}
+			sizes = append(sizes, size)
+		}
+
+		CompareValues(len(indices), 32, t)
+		for i, index := range indices {
+			CompareValues(index, i, t)
+		}
+		if slices.Max(sizes)-slices.Min(sizes) > 1 {
+			t.Errorf("Expected %d shards to be balanced, got %v", shards, sizes)
+		}
+	}
+}
+
+func Test_Synthesize_AllWithWeight(t *testing.T) {
+	previous := -1
+	count := 0
+	for p := range tiny.Synthesize.AllWithWeight(8, 3) {
+		CompareValues(tiny.Analyze.BitShade(p.Bits()...).Ones, 3, t)
+		value := tiny.To.Number(8, p.Bits()...)
+		if value <= previous {
+			t.Errorf("Expected ascending values, got %d after %d", value, previous)
+		}
+		previous = value
+		count++
+	}
+	CompareValues(count, 56, t)
+
+	for _, k := range []int{0, 8} {
+		count = 0
+		for range tiny.Synthesize.AllWithWeight(8, k) {
+			count++
+		}
+		CompareValues(count, 1, t)
+	}
+}
+
+func Test_Synthesize_AllWithWeight_ShouldPanicWithTooManyOnes(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.AllWithWeight(4, 5)
+}
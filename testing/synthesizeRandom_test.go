@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"github.com/ignite-laboratories/tiny"
+	"testing"
+)
+
+func Test_Synthesize_Uniform(t *testing.T) {
+	s := tiny.NewSynthesizer(11)
+	phrase := s.Uniform(100000)
+	CompareValues(phrase.BitLength(), 100000, t)
+
+	shade := tiny.Analyze.BitShade(phrase.Bits()...)
+	if shade.Ones < 49000 || shade.Ones > 51000 {
+		t.Errorf("Expected roughly half of 100000 bits to be ones, got %d", shade.Ones)
+	}
+
+	// Short lengths must be able to produce what RandomBits rejects
+	seen := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		seen[s.Uniform(3).StringBinary()] = true
+	}
+	CompareValues(len(seen), 8, t)
+}
+
+func Test_Synthesize_Biased(t *testing.T) {
+	s := tiny.NewSynthesizer(12)
+	shade := tiny.Analyze.BitShade(s.Biased(100000, 0.1).Bits()...)
+	if shade.Ones < 9000 || shade.Ones > 11000 {
+		t.Errorf("Expected roughly 10000 ones, got %d", shade.Ones)
+	}
+
+	CompareValues(tiny.Analyze.BitShade(s.Biased(64, 0).Bits()...).Ones, 0, t)
+	CompareValues(tiny.Analyze.BitShade(s.Biased(64, 1).Bits()...).Ones, 64, t)
+}
+
+func Test_Synthesize_Biased_ShouldPanicWithInvalidProbability(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.Biased(8, 1.5)
+}
+
+func Test_Synthesize_WithShade(t *testing.T) {
+	s := tiny.NewSynthesizer(13)
+	for _, ones := range []int{0, 1, 37, 99, 100} {
+		shade := tiny.Analyze.BitShade(s.WithShade(100, ones).Bits()...)
+		CompareValues(shade.Ones, ones, t)
+		CompareValues(shade.Total, 100, t)
+	}
+
+	// Every position should be equally likely to hold the single one
+	counts := make([]int, 4)
+	for i := 0; i < 4000; i++ {
+		bits := s.WithShade(4, 1).Bits()
+		for position, bit := range bits {
+			counts[position] += int(bit)
+		}
+	}
+	for position, count := range counts {
+		if count < 850 || count > 1150 {
+			t.Errorf("Expected position %d to hold the one about 1000 times, got %d", position, count)
+		}
+	}
+}
+
+func Test_Synthesize_WithShade_ShouldPanicWithTooManyOnes(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.WithShade(4, 5)
+}
+
+func Test_Synthesize_Markov(t *testing.T) {
+	s := tiny.NewSynthesizer(14)
+	sticky := s.Markov(100000, [][2]float64{{0.95, 0.05}, {0.05, 0.95}}, 1).Bits()
+	changes := 0
+	for i := 1; i < len(sticky); i++ {
+		if sticky[i] != sticky[i-1] {
+			changes++
+		}
+	}
+	if changes < 4000 || changes > 6000 {
+		t.Errorf("Expected roughly 5000 changes of bit, got %d", changes)
+	}
+
+	// A second order chain which always continues 0 0 1
+	cycle := s.Markov(30, [][2]float64{{0, 1}, {1, 0}, {1, 0}, {1, 0}}, 2).Bits()
+	for i := 2; i < len(cycle); i++ {
+		expected := tiny.Zero
+		if cycle[i-2] == 0 && cycle[i-1] == 0 {
+			expected = tiny.One
+		}
+		CompareValues(cycle[i], expected, t)
+	}
+}
+
+func Test_Synthesize_Markov_ShouldPanicWithWrongMatrix(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Synthesize.Markov(8, [][2]float64{{1, 1}}, 1)
+}
@@ -294,3 +294,31 @@ func Test_Synthesize_AllBoundaries_ShouldPanicWithNegativeWidth(t *testing.T) {
 	defer ShouldPanic(t)
 	tiny.Synthesize.AllBoundaries(3, -1)
 }
+
+func Test_Synthesizer_Seeded(t *testing.T) {
+	a := tiny.NewSynthesizer(42)
+	b := tiny.NewSynthesizer(42)
+	for i := 0; i < 32; i++ {
+		ComparePhrases(a.RandomPhrase(4, 12), b.RandomPhrase(4, 12), t)
+	}
+	CompareValues(a.Seed(), uint64(42), t)
+
+	other := tiny.NewSynthesizer(43).RandomBits(64)
+	if tiny.NewSynthesizer(42).RandomBits(64).StringBinary() == other.StringBinary() {
+		t.Error("Expected different seeds to synthesize different bits")
+	}
+}
+
+func Test_Synthesizer_Replay(t *testing.T) {
+	s := tiny.NewSynthesizer()
+	t.Logf("seed %d", s.Seed())
+	first := s.RandomPhrase(16)
+
+	replay := tiny.NewSynthesizer(s.Seed())
+	ComparePhrases(first, replay.RandomPhrase(16), t)
+}
+
+func Test_Synthesizer_Random(t *testing.T) {
+	s := tiny.NewSynthesizer(7)
+	synthesize_random(t, func(int) tiny.Bit { return s.RandomBits(1).Bits()[0] })
+}
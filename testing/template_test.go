@@ -0,0 +1,137 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"strings"
+	"testing"
+)
+
+func Test_Template_Literals(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate("1{3} 0{5} (10){4} n:0x1F/5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "1110000010101010"+"11111", t)
+}
+
+func Test_Template_Whitespace(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate(" 1 0\t1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "101", t)
+}
+
+func Test_Template_Empty(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.BitLength(), 0, t)
+}
+
+func Test_Template_RepetitionBindsToPrecedingAtom(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate("10{3}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "1000", t)
+}
+
+func Test_Template_NestedGroups(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate("(1(01){2}){2}0{0}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "1010110101", t)
+}
+
+func Test_Template_Numbers(t *testing.T) {
+	p, err := tiny.Synthesize.FromTemplate("n:5/4 n:0b11/3 n:0o7/3 n:0/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "0101"+"011"+"111"+"00", t)
+}
+
+func Test_Template_Random(t *testing.T) {
+	a, err := tiny.NewSynthesizer(42).FromTemplate("1 r{12} 0 r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := tiny.NewSynthesizer(42).FromTemplate("1 r{12} 0 r")
+	CompareValues(a.BitLength(), 15, t)
+	CompareValues(a.StringBinary(), b.StringBinary(), t)
+	CompareValues(a.StringBinary()[0], '1', t)
+	CompareValues(a.StringBinary()[13], '0', t)
+
+	// A repeated random run repeats the same bits
+	c, _ := tiny.NewSynthesizer(7).FromTemplate("r{8}{3}")
+	s := c.StringBinary()
+	CompareValues(s[8:16], s[:8], t)
+	CompareValues(s[16:], s[:8], t)
+}
+
+func Test_Template_Errors(t *testing.T) {
+	cases := map[string]int{
+		"1 2":                      3,
+		"(10":                      1,
+		"10)":                      3,
+		"1{":                       3,
+		"1{3":                      2,
+		"1{x}":                     3,
+		"n5/3":                     2,
+		"n:zz/3":                   3,
+		"n:5":                      4,
+		"n:5/":                     5,
+		"n:5/65":                   5,
+		"0 n:9/3":                  5,
+		"1{2}{2}":                  5,
+		"r{}":                      3,
+		"1{999999999999999999999}": 3,
+		"(é)":                      2,
+		"(1){é}":                   5,
+		"n:5/3 ü 1":                7,
+	}
+	for template, column := range cases {
+		_, err := tiny.Synthesize.FromTemplate(template)
+		var te tiny.TemplateError
+		if !errors.As(err, &te) {
+			t.Fatalf("%q: expected a TemplateError, got %v", template, err)
+		}
+		if !errors.Is(err, tiny.ErrorTemplate) {
+			t.Errorf("%q: expected the error to wrap ErrorTemplate", template)
+		}
+		if te.Column != column {
+			t.Errorf("%q: expected column %d, got %d (%v)", template, column, te.Column, err)
+		}
+	}
+}
+
+func Test_Template_NestingLimit(t *testing.T) {
+	nested := strings.Repeat("(", 256) + "1" + strings.Repeat(")", 256)
+	p, err := tiny.Synthesize.FromTemplate(nested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	CompareValues(p.StringBinary(), "1", t)
+
+	// A deeply nested template is refused rather than overflowing the stack
+	_, err = tiny.Synthesize.FromTemplate(strings.Repeat("(", 1<<20))
+	var te tiny.TemplateError
+	if !errors.As(err, &te) {
+		t.Fatalf("Expected a TemplateError, got %v", err)
+	}
+	CompareValues(te.Column, 257, t)
+}
+
+func Test_Template_NonASCII(t *testing.T) {
+	_, err := tiny.Synthesize.FromTemplate("1 é")
+	var te tiny.TemplateError
+	if !errors.As(err, &te) {
+		t.Fatalf("Expected a TemplateError, got %v", err)
+	}
+	CompareValues(te.Column, 3, t)
+	CompareValues(te.Message, `unexpected 'é'`, t)
+}
@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"errors"
+	"github.com/ignite-laboratories/tiny"
+	"math/big"
+	"testing"
+)
+
+func compareCode(t *testing.T, codec tiny.FuzzyCodec, value int, key string, projection string) {
+	k, p := codec.Encode(value)
+	CompareValues(k.StringBinary(), key, t)
+	CompareValues(p.StringBinary(), projection, t)
+}
+
+func Test_Fuzzy_Unary(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Unary, 0, "0", "")
+	compareCode(t, tiny.Fuzzy.Unary, 3, "1110", "")
+	verifyFuzzy(t, tiny.Fuzzy.Unary.Encode, tiny.Fuzzy.Unary.Read, 0, 100)
+}
+
+func Test_Fuzzy_Gamma(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Gamma, 1, "1", "")
+	compareCode(t, tiny.Fuzzy.Gamma, 9, "0001", "001")
+	verifyFuzzy(t, tiny.Fuzzy.Gamma.Encode, tiny.Fuzzy.Gamma.Read, 1, 1<<12)
+}
+
+func Test_Fuzzy_Delta(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Delta, 1, "1", "")
+	compareCode(t, tiny.Fuzzy.Delta, 9, "00100", "001")
+	verifyFuzzy(t, tiny.Fuzzy.Delta.Encode, tiny.Fuzzy.Delta.Read, 1, 1<<12)
+}
+
+func Test_Fuzzy_Omega(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Omega, 1, "", "0")
+	compareCode(t, tiny.Fuzzy.Omega, 16, "10100", "100000")
+	verifyFuzzy(t, tiny.Fuzzy.Omega.Encode, tiny.Fuzzy.Omega.Read, 1, 1<<12)
+}
+
+func Test_Fuzzy_Fibonacci(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Fibonacci, 1, "", "11")
+	compareCode(t, tiny.Fuzzy.Fibonacci, 11, "", "001011")
+	verifyFuzzy(t, tiny.Fuzzy.Fibonacci.Encode, tiny.Fuzzy.Fibonacci.Read, 1, 1<<12)
+}
+
+func Test_Fuzzy_Golomb(t *testing.T) {
+	golomb := tiny.Fuzzy.Golomb(3)
+	compareCode(t, golomb, 0, "0", "0")
+	compareCode(t, golomb, 7, "110", "10")
+	for m := 1; m < 12; m++ {
+		g := tiny.Fuzzy.Golomb(m)
+		verifyFuzzy(t, g.Encode, g.Read, 0, 200)
+	}
+}
+
+func Test_Fuzzy_Rice(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.Rice(2), 9, "110", "01")
+	for k := 0; k < 5; k++ {
+		r := tiny.Fuzzy.Rice(k)
+		verifyFuzzy(t, r.Encode, r.Read, 0, 200)
+	}
+}
+
+func Test_Fuzzy_ExpGolomb(t *testing.T) {
+	compareCode(t, tiny.Fuzzy.ExpGolomb(0), 0, "1", "")
+	compareCode(t, tiny.Fuzzy.ExpGolomb(0), 3, "001", "00")
+	compareCode(t, tiny.Fuzzy.ExpGolomb(1), 0, "1", "0")
+	for k := 0; k < 4; k++ {
+		e := tiny.Fuzzy.ExpGolomb(k)
+		verifyFuzzy(t, e.Encode, e.Read, 0, 1<<10)
+	}
+}
+
+func Test_Fuzzy_Universal_Big(t *testing.T) {
+	value, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+
+	roundTrip := func(encode func(*big.Int) (tiny.Phrase, tiny.Phrase), read func(tiny.Phrase) (*big.Int, tiny.Phrase)) {
+		key, projection := encode(value)
+		out, remainder := read(key.Append(projection).AppendBits(1, 1))
+		CompareValues(out.String(), value.String(), t)
+		CompareValues(remainder.StringBinary(), "11", t)
+	}
+
+	roundTrip(tiny.Fuzzy.Gamma.EncodeBig, tiny.Fuzzy.Gamma.ReadBig)
+	roundTrip(tiny.Fuzzy.Delta.EncodeBig, tiny.Fuzzy.Delta.ReadBig)
+	roundTrip(tiny.Fuzzy.Omega.EncodeBig, tiny.Fuzzy.Omega.ReadBig)
+	roundTrip(tiny.Fuzzy.Fibonacci.EncodeBig, tiny.Fuzzy.Fibonacci.ReadBig)
+	roundTrip(tiny.Fuzzy.ExpGolomb(3).EncodeBig, tiny.Fuzzy.ExpGolomb(3).ReadBig)
+}
+
+func Test_Fuzzy_Universal_Overflow(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 100)
+	codecs := map[string]struct {
+		encode func(*big.Int) (tiny.Phrase, tiny.Phrase)
+		codec  tiny.FuzzyStrictCodec
+	}{
+		"Gamma":     {tiny.Fuzzy.Gamma.EncodeBig, tiny.Fuzzy.Gamma},
+		"Delta":     {tiny.Fuzzy.Delta.EncodeBig, tiny.Fuzzy.Delta},
+		"Omega":     {tiny.Fuzzy.Omega.EncodeBig, tiny.Fuzzy.Omega},
+		"Fibonacci": {tiny.Fuzzy.Fibonacci.EncodeBig, tiny.Fuzzy.Fibonacci},
+		"ExpGolomb": {tiny.Fuzzy.ExpGolomb(1).EncodeBig, tiny.Fuzzy.ExpGolomb(1)},
+	}
+
+	for name, c := range codecs {
+		key, projection := c.encode(huge)
+		data := key.Append(projection)
+
+		value, remainder, err := c.codec.ReadStrict(data)
+		if !errors.Is(err, tiny.ErrorFuzzyOverflow) {
+			t.Errorf("%s: expected %v, got %v", name, tiny.ErrorFuzzyOverflow, err)
+		}
+		CompareValues(value, 0, t)
+		CompareValues(remainder.BitLength(), data.BitLength(), t)
+
+		if _, err = tiny.Fuzzy.DecodeAll(c.codec, data); !errors.Is(err, tiny.ErrorFuzzyOverflow) {
+			t.Errorf("%s: expected %v, got %v", name, tiny.ErrorFuzzyOverflow, err)
+		}
+	}
+}
+
+func Test_Fuzzy_Gamma_ShouldPanicWithZero(t *testing.T) {
+	defer ShouldPanic(t)
+	tiny.Fuzzy.Gamma.Encode(0)
+}
@@ -17,7 +17,9 @@ var Fuzzy _fuzzy
 var Modify _modify
 
 // Synthesize is a way to create binary slices from known parameters.
-var Synthesize _synthesize
+//
+// Its randomness is drawn from crypto/rand - see NewSynthesizer for reproducible synthesis.
+var Synthesize Synthesizer
 
 // To is a way to convert binary slices to other forms.
 //
@@ -1,6 +1,9 @@
 package tiny
 
 import (
+	"fmt"
+	"io"
+	"math/big"
 	"strconv"
 )
 
@@ -115,3 +118,108 @@ func (_ _to) String(bits ...Bit) string {
 	}
 	return output
 }
+
+// ULEB128 reads an unsigned LEB128 from the start of the provided phrase, followed by the remainder.
+//
+// NOTE: If the phrase ends before the final byte, this returns an error wrapping ErrorEndOfBits.  If the
+// value was encoded with redundant trailing bytes, the value is still returned alongside ErrorLEB128Overlong.
+func (_ _to) ULEB128(data Phrase) (value *big.Int, remainder Phrase, err error) {
+	remainder = data
+	value, err = decodeLEB128(phraseByteReader(&remainder), false)
+	return value, remainder, err
+}
+
+// SLEB128 reads a signed LEB128 from the start of the provided phrase, followed by the remainder.
+//
+// NOTE: If the phrase ends before the final byte, this returns an error wrapping ErrorEndOfBits.  If the
+// value was encoded with redundant trailing bytes, the value is still returned alongside ErrorLEB128Overlong.
+func (_ _to) SLEB128(data Phrase) (value *big.Int, remainder Phrase, err error) {
+	remainder = data
+	value, err = decodeLEB128(phraseByteReader(&remainder), true)
+	return value, remainder, err
+}
+
+// ULEB128Stream reads an unsigned LEB128 from the provided byte stream.  See ULEB128.
+//
+// NOTE: If the stream ends cleanly before the first byte, this returns io.EOF.  If it ends, or fails, partway
+// through the value, the stream's error is wrapped alongside ErrorEndOfBits - an early end as io.ErrUnexpectedEOF.
+func (_ _to) ULEB128Stream(r io.ByteReader) (*big.Int, error) {
+	return decodeLEB128(r.ReadByte, false)
+}
+
+// SLEB128Stream reads a signed LEB128 from the provided byte stream.  See SLEB128 and ULEB128Stream.
+func (_ _to) SLEB128Stream(r io.ByteReader) (*big.Int, error) {
+	return decodeLEB128(r.ReadByte, true)
+}
+
+// ZigZag decodes the provided unsigned zigzag value back to its signed value, as used by protobuf's sint types.
+// Pair it with ULEB128 to decode a sint.
+//
+//	0 → 0, 1 → -1, 2 → 1, 3 → -2, 4 → 2 ...
+func (_ _to) ZigZag(value *big.Int) *big.Int {
+	out := new(big.Int).Rsh(value, 1)
+	if value.Bit(0) == 1 {
+		out.Add(out, big.NewInt(1)).Neg(out)
+	}
+	return out
+}
+
+// phraseByteReader reads the next eight bits of the referenced phrase as a byte, advancing the phrase.
+func phraseByteReader(data *Phrase) func() (byte, error) {
+	return func() (byte, error) {
+		read, remainder, err := data.Read(8)
+		if err != nil {
+			return 0, err
+		}
+		*data = remainder
+		return To.Byte(read.Bits()...), nil
+	}
+}
+
+// decodeLEB128 decodes a LEB128 value from the provided source of bytes.
+//
+// NOTE: An error from the source before the first byte is returned as is, so a clean end remains io.EOF.
+func decodeLEB128(next func() (byte, error), signed bool) (*big.Int, error) {
+	value := new(big.Int)
+	var shift uint
+	var b, previous byte
+	for count := 0; ; count++ {
+		var err error
+		previous = b
+		b, err = next()
+		if err != nil {
+			if count == 0 {
+				return value, err
+			}
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return value, fmt.Errorf("%w: truncated LEB128 after %d bytes: %w", ErrorEndOfBits, count, err)
+		}
+
+		group := big.NewInt(int64(b & 0x7F))
+		value.Or(value, group.Lsh(group, shift))
+		shift += 7
+
+		if b&0x80 != 0 {
+			continue
+		}
+
+		if signed && b&0x40 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), shift))
+		}
+
+		overlong := false
+		if count > 0 {
+			if signed {
+				overlong = (b == 0x00 && previous&0x40 == 0) || (b == 0x7F && previous&0x40 != 0)
+			} else {
+				overlong = b == 0x00
+			}
+		}
+		if overlong {
+			return value, ErrorLEB128Overlong
+		}
+		return value, nil
+	}
+}
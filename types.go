@@ -17,6 +17,23 @@ type FuzzyEncodeFunc func(value int) (key Phrase, projection Phrase)
 // See the various fields on the _fuzzy structure for the details of each standard implementation.
 type FuzzyReadFunc func(data Phrase) (value int, remainder Phrase)
 
+// FuzzyCodec is any fuzzy map which can both encode and read values - such as the fields of the _fuzzy
+// structure or a FuzzyScheme.
+type FuzzyCodec interface {
+	Encode(value int) (key Phrase, projection Phrase)
+	Read(data Phrase) (value int, remainder Phrase)
+}
+
+// FuzzyStrictCodec is any fuzzy map which can also report when a read runs past the end of its data, rather
+// than silently reading whatever bits remain.  Every built-in map and FuzzyScheme is strict.
+//
+// ReadStrict should return an error wrapping ErrorEndOfBits if the value was cut short, or wrapping
+// ErrorFuzzyKey if the next bits don't form a key.
+type FuzzyStrictCodec interface {
+	FuzzyCodec
+	ReadStrict(data Phrase) (value int, remainder Phrase, err error)
+}
+
 /**
 Shade
 */
@@ -33,6 +50,9 @@ const (
 
 	// Grey represents a mixture of 1s and 0s.
 	Grey
+
+	// Undefined represents the absence of any 1s or 0s to describe.
+	Undefined
 )
 
 // BinaryShade is a count of the number of 0s and 1s within binary data.
@@ -0,0 +1,626 @@
+package tiny
+
+import (
+	"fmt"
+	"math/big"
+)
+
+/**
+Universal Codes
+*/
+
+type _unary struct{}
+type _gamma struct{}
+type _delta struct{}
+type _omega struct{}
+type _fibonacci struct{}
+
+type _golomb struct {
+	m int
+}
+
+type _expGolomb struct {
+	k int
+}
+
+// Golomb creates a Golomb code of the provided divisor.  See _golomb.Encode for the details of the code.
+//
+// NOTE: This will panic if given a divisor less than 1.
+func (_ _fuzzy) Golomb(m int) _golomb {
+	if m < 1 {
+		panic("cannot create a Golomb code with a divisor less than 1")
+	}
+	return _golomb{m: m}
+}
+
+// Rice creates a Rice code of the provided parameter - a Golomb code with a divisor of 2ᵏ.
+//
+// NOTE: This will panic if given a negative parameter.
+func (f _fuzzy) Rice(k int) _golomb {
+	if k < 0 || k >= GetArchitectureBitWidth()-1 {
+		panic("cannot create a Rice code with a negative or overly wide parameter")
+	}
+	return f.Golomb(1 << k)
+}
+
+// ExpGolomb creates an Exp-Golomb code of the provided order.  See _expGolomb.Encode for the details of the code.
+//
+// NOTE: This will panic if given a negative order.
+func (_ _fuzzy) ExpGolomb(k int) _expGolomb {
+	if k < 0 {
+		panic("cannot create an Exp-Golomb code of a negative order")
+	}
+	return _expGolomb{k: k}
+}
+
+/**
+Unary
+*/
+
+// Encode emits the provided value as that many ones followed by a terminating zero.
+//
+// The entire code is emitted as the key, leaving an empty projection.
+//
+// @formatter:off
+//
+//	Value | Key
+//	    0 | 0
+//	    1 | 1 0
+//	    2 | 1 1 0
+//	    3 | 1 1 1 0
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a negative value.
+func (_ _unary) Encode(value int) (key Phrase, projection Phrase) {
+	if value < 0 {
+		panic("cannot unary encode a negative value")
+	}
+	return NewPhraseFromBits(unaryBits(value)...), Phrase{}
+}
+
+// Read counts the ones of the next bits in the provided phrase until reaching a zero.
+func (u _unary) Read(data Phrase) (value int, remainder Phrase) {
+	value, remainder, _ = u.ReadStrict(data)
+	return value, remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (_ _unary) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursor(data, (*bitCursor).readUnary)
+}
+
+/**
+Elias Gamma
+*/
+
+// Encode emits the provided value using Elias gamma coding.  The key holds one zero for every bit of the
+// value after its leading one, followed by that leading one, while the projection holds the remaining bits.
+//
+// @formatter:off
+//
+//	Value |   Key   | Projection
+//	    1 |       1 |
+//	    2 |     0 1 | 0
+//	    3 |     0 1 | 1
+//	    4 |   0 0 1 | 0 0
+//	    9 | 0 0 0 1 | 0 0 1
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a value less than 1.
+func (g _gamma) Encode(value int) (key Phrase, projection Phrase) {
+	return g.EncodeBig(big.NewInt(int64(value)))
+}
+
+// Read parses an Elias gamma coded value from the next bits in the provided phrase.
+func (g _gamma) Read(data Phrase) (value int, remainder Phrase) {
+	v, remainder := g.ReadBig(data)
+	return int(v.Int64()), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, or wrapping ErrorFuzzyOverflow if the value doesn't fit in an int - see ReadBig.
+func (_ _gamma) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursorInt(data, (*bitCursor).readGamma)
+}
+
+// EncodeBig emits the provided value using Elias gamma coding.  See Encode.
+func (_ _gamma) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	k, p := gammaBits(value)
+	return NewPhraseFromBits(k...), NewPhraseFromBits(p...)
+}
+
+// ReadBig parses an Elias gamma coded value of any size from the next bits in the provided phrase.
+func (_ _gamma) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = readCursor(data, (*bitCursor).readGamma)
+	return value, remainder
+}
+
+/**
+Elias Delta
+*/
+
+// Encode emits the provided value using Elias delta coding.  The key holds the Elias gamma code of the
+// value's bit length, while the projection holds the bits of the value after its leading one.
+//
+// @formatter:off
+//
+//	Value |    Key    | Projection
+//	    1 |         1 |
+//	    2 |     0 1 0 | 0
+//	    4 |     0 1 1 | 0 0
+//	    9 | 0 0 1 0 0 | 0 0 1
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a value less than 1.
+func (d _delta) Encode(value int) (key Phrase, projection Phrase) {
+	return d.EncodeBig(big.NewInt(int64(value)))
+}
+
+// Read parses an Elias delta coded value from the next bits in the provided phrase.
+func (d _delta) Read(data Phrase) (value int, remainder Phrase) {
+	v, remainder := d.ReadBig(data)
+	return int(v.Int64()), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, or wrapping ErrorFuzzyOverflow if the value doesn't fit in an int - see ReadBig.
+func (d _delta) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursorInt(data, d.read)
+}
+
+// EncodeBig emits the provided value using Elias delta coding.  See Encode.
+func (_ _delta) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	requirePositive(value, "Elias delta")
+	length := value.BitLen()
+	k, p := gammaBits(big.NewInt(int64(length)))
+	bits := From.BigInt(value)
+	return NewPhraseFromBits(append(k, p...)...), NewPhraseFromBits(bits[1:]...)
+}
+
+// ReadBig parses an Elias delta coded value of any size from the next bits in the provided phrase.
+func (d _delta) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = readCursor(data, d.read)
+	return value, remainder
+}
+
+func (_ _delta) read(c *bitCursor) *big.Int {
+	length := c.readGamma()
+	value := big.NewInt(1)
+	for i := int64(1); i < length.Int64() && c.more(); i++ {
+		value.Lsh(value, 1)
+		value.SetBit(value, 0, uint(c.next()))
+	}
+	return value
+}
+
+/**
+Elias Omega
+*/
+
+// Encode emits the provided value using Elias omega coding.  The value's bits are recursively prefixed
+// by the bit length of the previous group, less one, until reaching a length of one.
+//
+// The key holds every length group, while the projection holds the value's bits and the terminating zero.
+//
+// @formatter:off
+//
+//	Value |    Key    | Projection
+//	    1 |           | 0
+//	    2 |           | 1 0 0
+//	    4 |       1 0 | 1 0 0 0
+//	   16 | 1 0 1 0 0 | 1 0 0 0 0 0
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a value less than 1.
+func (o _omega) Encode(value int) (key Phrase, projection Phrase) {
+	return o.EncodeBig(big.NewInt(int64(value)))
+}
+
+// Read parses an Elias omega coded value from the next bits in the provided phrase.
+func (o _omega) Read(data Phrase) (value int, remainder Phrase) {
+	v, remainder := o.ReadBig(data)
+	return int(v.Int64()), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, or wrapping ErrorFuzzyOverflow if the value doesn't fit in an int - see ReadBig.
+func (o _omega) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursorInt(data, o.read)
+}
+
+// EncodeBig emits the provided value using Elias omega coding.  See Encode.
+func (_ _omega) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	requirePositive(value, "Elias omega")
+	if value.Cmp(big.NewInt(1)) == 0 {
+		return Phrase{}, NewPhraseFromBits(0)
+	}
+
+	groups := make([][]Bit, 0)
+	for n := new(big.Int).Set(value); n.Cmp(big.NewInt(1)) > 0; n = big.NewInt(int64(n.BitLen() - 1)) {
+		groups = append(groups, From.BigInt(n))
+	}
+
+	keyBits := make([]Bit, 0)
+	for i := len(groups) - 1; i > 0; i-- {
+		keyBits = append(keyBits, groups[i]...)
+	}
+	return NewPhraseFromBits(keyBits...), NewPhraseFromBits(append(groups[0], Zero)...)
+}
+
+// ReadBig parses an Elias omega coded value of any size from the next bits in the provided phrase.
+func (o _omega) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = readCursor(data, o.read)
+	return value, remainder
+}
+
+func (_ _omega) read(c *bitCursor) *big.Int {
+	value := big.NewInt(1)
+	for c.more() {
+		if c.next() == Zero {
+			break
+		}
+		length := value.Int64()
+		value = big.NewInt(1)
+		for i := int64(0); i < length && c.more(); i++ {
+			value.Lsh(value, 1)
+			value.SetBit(value, 0, uint(c.next()))
+		}
+	}
+	return value
+}
+
+/**
+Fibonacci
+*/
+
+// Encode emits the provided value using Fibonacci coding - the value's Zeckendorf representation, from
+// the smallest Fibonacci number upwards, followed by a terminating one.
+//
+// As Fibonacci codes have no length prefix, the entire code is emitted as the projection, leaving an empty key.
+//
+// @formatter:off
+//
+//	Value | Projection
+//	    1 | 1 1
+//	    2 | 0 1 1
+//	    3 | 0 0 1 1
+//	    4 | 1 0 1 1
+//	   11 | 0 0 1 0 1 1
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a value less than 1.
+func (f _fibonacci) Encode(value int) (key Phrase, projection Phrase) {
+	return f.EncodeBig(big.NewInt(int64(value)))
+}
+
+// Read parses a Fibonacci coded value from the next bits in the provided phrase.
+func (f _fibonacci) Read(data Phrase) (value int, remainder Phrase) {
+	v, remainder := f.ReadBig(data)
+	return int(v.Int64()), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, or wrapping ErrorFuzzyOverflow if the value doesn't fit in an int - see ReadBig.
+func (f _fibonacci) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursorInt(data, f.read)
+}
+
+// EncodeBig emits the provided value using Fibonacci coding.  See Encode.
+func (_ _fibonacci) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	requirePositive(value, "Fibonacci")
+
+	// Build every Fibonacci number up to the value, starting from F(2) = 1
+	fibs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	for fibs[len(fibs)-1].Cmp(value) <= 0 {
+		fibs = append(fibs, new(big.Int).Add(fibs[len(fibs)-1], fibs[len(fibs)-2]))
+	}
+
+	bits := make([]Bit, len(fibs))
+	remaining := new(big.Int).Set(value)
+	last := 0
+	for i := len(fibs) - 1; i >= 0; i-- {
+		if fibs[i].Cmp(remaining) <= 0 {
+			remaining.Sub(remaining, fibs[i])
+			bits[i] = One
+			last = max(last, i)
+		}
+	}
+	return Phrase{}, NewPhraseFromBits(append(bits[:last+1], One)...)
+}
+
+// ReadBig parses a Fibonacci coded value of any size from the next bits in the provided phrase.
+func (f _fibonacci) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = readCursor(data, f.read)
+	return value, remainder
+}
+
+func (_ _fibonacci) read(c *bitCursor) *big.Int {
+	value := new(big.Int)
+	a, b := big.NewInt(1), big.NewInt(2)
+	previous := Zero
+	for c.more() {
+		bit := c.next()
+		if bit == One && previous == One {
+			break
+		}
+		if bit == One {
+			value.Add(value, a)
+		}
+		previous = bit
+		a, b = b, new(big.Int).Add(a, b)
+	}
+	return value
+}
+
+/**
+Golomb / Rice
+*/
+
+// Encode emits the provided value using Golomb coding.  The key holds the quotient of the value divided by
+// the divisor in unary, while the projection holds the remainder in truncated binary.
+//
+// @formatter:off
+//
+// For example, with a divisor of 3:
+//
+//	Value |  Key  | Projection
+//	    0 |     0 | 0
+//	    1 |     0 | 1 0
+//	    2 |     0 | 1 1
+//	    3 |   1 0 | 0
+//	    7 | 1 1 0 | 1 0
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a negative value.
+func (g _golomb) Encode(value int) (key Phrase, projection Phrase) {
+	if value < 0 {
+		panic("cannot Golomb encode a negative value")
+	}
+	q, r := value/g.m, value%g.m
+	width, cutoff := g.truncation()
+
+	var bits []Bit
+	if r < cutoff {
+		bits = From.Number(r, width-1)
+	} else {
+		bits = From.Number(r+cutoff, width)
+	}
+	return NewPhraseFromBits(unaryBits(q)...), NewPhraseFromBits(bits...)
+}
+
+// Read parses a Golomb coded value from the next bits in the provided phrase.
+func (g _golomb) Read(data Phrase) (value int, remainder Phrase) {
+	value, remainder, _ = g.ReadStrict(data)
+	return value, remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end of the data.
+func (g _golomb) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursor(data, g.read)
+}
+
+func (g _golomb) read(c *bitCursor) int {
+	q := c.readUnary()
+	width, cutoff := g.truncation()
+
+	r := 0
+	if width > 0 {
+		r = c.readNumber(width - 1)
+		if r >= cutoff {
+			r = r<<1 | int(c.next()) - cutoff
+		}
+	}
+	return q*g.m + r
+}
+
+// truncation returns the bit width and cutoff of the truncated binary remainder.
+func (g _golomb) truncation() (width int, cutoff int) {
+	width = GetBitWidth(g.m - 1)
+	if g.m == 1 {
+		width = 0
+	}
+	return width, 1<<width - g.m
+}
+
+/**
+Exp-Golomb
+*/
+
+// Encode emits the provided value using Exp-Golomb coding of the code's order, as used by H.264 headers.
+// The value is offset by 2ᵏ and Elias gamma coded, less the gamma key's first 𝑘 zeros.
+//
+// @formatter:off
+//
+// For example, with an order of 0:
+//
+//	Value |  Key  | Projection
+//	    0 |     1 |
+//	    1 |   0 1 | 0
+//	    2 |   0 1 | 1
+//	    3 | 0 0 1 | 0 0
+//
+// @formatter:on
+//
+// NOTE: This will panic if given a negative value.
+func (e _expGolomb) Encode(value int) (key Phrase, projection Phrase) {
+	return e.EncodeBig(big.NewInt(int64(value)))
+}
+
+// Read parses an Exp-Golomb coded value from the next bits in the provided phrase.
+func (e _expGolomb) Read(data Phrase) (value int, remainder Phrase) {
+	v, remainder := e.ReadBig(data)
+	return int(v.Int64()), remainder
+}
+
+// ReadStrict reads as Read does, but returns an error wrapping ErrorEndOfBits if the value runs past the end
+// of the data, or wrapping ErrorFuzzyOverflow if the value doesn't fit in an int - see ReadBig.
+func (e _expGolomb) ReadStrict(data Phrase) (value int, remainder Phrase, err error) {
+	return readCursorInt(data, e.read)
+}
+
+// EncodeBig emits the provided value using Exp-Golomb coding.  See Encode.
+func (e _expGolomb) EncodeBig(value *big.Int) (key Phrase, projection Phrase) {
+	if value.Sign() < 0 {
+		panic("cannot Exp-Golomb encode a negative value")
+	}
+	offset := new(big.Int).Lsh(big.NewInt(1), uint(e.k))
+	k, p := gammaBits(new(big.Int).Add(value, offset))
+	return NewPhraseFromBits(k[e.k:]...), NewPhraseFromBits(p...)
+}
+
+// ReadBig parses an Exp-Golomb coded value of any size from the next bits in the provided phrase.
+func (e _expGolomb) ReadBig(data Phrase) (value *big.Int, remainder Phrase) {
+	value, remainder, _ = readCursor(data, e.read)
+	return value, remainder
+}
+
+func (e _expGolomb) read(c *bitCursor) *big.Int {
+	zeros := c.readZeros()
+	value := big.NewInt(1)
+	for i := 0; i < zeros+e.k && c.more(); i++ {
+		value.Lsh(value, 1)
+		value.SetBit(value, 0, uint(c.next()))
+	}
+	offset := new(big.Int).Lsh(big.NewInt(1), uint(e.k))
+	return value.Sub(value, offset)
+}
+
+/**
+Support
+*/
+
+// requirePositive panics if the provided value is less than 1.
+func requirePositive(value *big.Int, code string) {
+	if value.Sign() <= 0 {
+		panic(fmt.Sprintf("cannot %s encode a value less than 1", code))
+	}
+}
+
+// unaryBits returns the provided count of ones followed by a zero.
+func unaryBits(count int) []Bit {
+	return append(Synthesize.Ones(count).Bits(), Zero)
+}
+
+// gammaBits returns the Elias gamma key and projection bits of the provided value.
+func gammaBits(value *big.Int) (key []Bit, projection []Bit) {
+	requirePositive(value, "Elias gamma")
+	bits := From.BigInt(value)
+	key = append(make([]Bit, len(bits)-1), One)
+	return key, bits[1:]
+}
+
+// bitCursor walks the bits of a phrase one at a time, treating the end of the bits as a terminator.
+//
+// The cursor is marked short whenever a bit is needed beyond the end - see more.
+type bitCursor struct {
+	bits  []Bit
+	i     int
+	short bool
+}
+
+func newBitCursor(data Phrase) *bitCursor {
+	return &bitCursor{bits: data.Bits()}
+}
+
+// exhausted reports if every bit has been read.
+func (c *bitCursor) exhausted() bool {
+	return c.i >= len(c.bits)
+}
+
+// more reports if another bit is available, and marks the cursor short if it isn't.  Call it only when
+// another bit is needed.
+func (c *bitCursor) more() bool {
+	if c.exhausted() {
+		c.short = true
+		return false
+	}
+	return true
+}
+
+// next reads the next bit, or a zero if every bit has been read.
+func (c *bitCursor) next() Bit {
+	if !c.more() {
+		return Zero
+	}
+	bit := c.bits[c.i]
+	c.i++
+	return bit
+}
+
+// readNumber reads the provided number of bits as a number.
+func (c *bitCursor) readNumber(width int) int {
+	value := 0
+	for i := 0; i < width; i++ {
+		value = value<<1 | int(c.next())
+	}
+	return value
+}
+
+// readUnary counts ones until reaching, and consuming, a zero.
+func (c *bitCursor) readUnary() int {
+	count := 0
+	for c.more() && c.next() == One {
+		count++
+	}
+	return count
+}
+
+// readZeros counts zeros until reaching, and consuming, a one.
+func (c *bitCursor) readZeros() int {
+	count := 0
+	for c.more() && c.next() == Zero {
+		count++
+	}
+	return count
+}
+
+// readGamma reads an Elias gamma coded value.
+func (c *bitCursor) readGamma() *big.Int {
+	zeros := c.readZeros()
+	value := big.NewInt(1)
+	for i := 0; i < zeros && c.more(); i++ {
+		value.Lsh(value, 1)
+		value.SetBit(value, 0, uint(c.next()))
+	}
+	return value
+}
+
+// remainder returns every unread bit as a phrase.
+func (c *bitCursor) remainder() Phrase {
+	return NewPhraseFromBits(c.bits[min(c.i, len(c.bits)):]...)
+}
+
+// readCursor reads a value from the data using the provided function, followed by the remainder - returning
+// an error wrapping ErrorEndOfBits if the function needed bits beyond the end of the data.
+func readCursor[T any](data Phrase, read func(c *bitCursor) T) (value T, remainder Phrase, err error) {
+	c := newBitCursor(data)
+	value = read(c)
+	if c.short {
+		err = fmt.Errorf("%w: the value runs past the end of the data", ErrorEndOfBits)
+	}
+	return value, c.remainder(), err
+}
+
+// readCursorInt reads a value as readCursor does, returning 0, the unread data, and an error wrapping
+// ErrorFuzzyOverflow if the value doesn't fit in an int.
+func readCursorInt(data Phrase, read func(c *bitCursor) *big.Int) (value int, remainder Phrase, err error) {
+	v, remainder, err := readCursor(data, read)
+	if !fitsInt(v) {
+		if err == nil {
+			err = fmt.Errorf("%w: %d bits wide - use ReadBig instead", ErrorFuzzyOverflow, v.BitLen())
+		}
+		return 0, data, err
+	}
+	return int(v.Int64()), remainder, err
+}
+
+// fitsInt reports whether the value can be held by an int.
+func fitsInt(v *big.Int) bool {
+	return v.IsInt64() && int64(int(v.Int64())) == v.Int64()
+}